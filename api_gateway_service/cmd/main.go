@@ -5,6 +5,7 @@ import (
 	"api_gateway/gen/quiz_service"
 	"api_gateway/gen/stat_service"
 	logger "api_gateway/pkg"
+	"api_gateway/service"
 	"bytes"
 	"context" // для QuizService
 	"io"
@@ -157,6 +158,8 @@ func main() {
 		l.Fatal("failed to register Auth gateway", zap.Error(err))
 	}
 
+	// Живые комнаты идут мимо gRPC-Gateway: он не умеет двунаправленные стримы через WebSocket
+	rootMux.Handle("/v1/rooms/ws", service.RoomsHandler(quiz_service.NewRoomServiceClient(quizConn)))
 	rootMux.Handle("/", grpcGatewayMux)
 
 	corsHandler := allowCORS(loggingMiddleware(rootMux))
@@ -29,9 +29,13 @@ const (
 	AuthService_AddFriend_FullMethodName          = "/auth.AuthService/AddFriend"
 	AuthService_RemoveFriend_FullMethodName       = "/auth.AuthService/RemoveFriend"
 	AuthService_GetFriends_FullMethodName         = "/auth.AuthService/GetFriends"
+	AuthService_GetFriendOf_FullMethodName        = "/auth.AuthService/GetFriendOf"
 	AuthService_AddFavoriteQuiz_FullMethodName    = "/auth.AuthService/AddFavoriteQuiz"
 	AuthService_GetFavoriteQuizzes_FullMethodName = "/auth.AuthService/GetFavoriteQuizzes"
 	AuthService_RemoveFavoriteQuiz_FullMethodName = "/auth.AuthService/RemoveFavoriteQuiz"
+	AuthService_ChallengeFriend_FullMethodName    = "/auth.AuthService/ChallengeFriend"
+	AuthService_CompleteChallenge_FullMethodName  = "/auth.AuthService/CompleteChallenge"
+	AuthService_GetChallenges_FullMethodName      = "/auth.AuthService/GetChallenges"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -51,10 +55,17 @@ type AuthServiceClient interface {
 	AddFriend(ctx context.Context, in *AddFriendRequest, opts ...grpc.CallOption) (*FriendResponse, error)
 	RemoveFriend(ctx context.Context, in *RemoveFriendRequest, opts ...grpc.CallOption) (*FriendResponse, error)
 	GetFriends(ctx context.Context, in *GetFriendsRequest, opts ...grpc.CallOption) (*FriendsListResponse, error)
+	// Users who added the caller to their friends, used by quiz_service for friends-only quizzes
+	GetFriendOf(ctx context.Context, in *GetFriendOfRequest, opts ...grpc.CallOption) (*FriendOfResponse, error)
 	// Quizzez
 	AddFavoriteQuiz(ctx context.Context, in *AddFavoriteQuizRequest, opts ...grpc.CallOption) (*FavoriteQuizResponse, error)
 	GetFavoriteQuizzes(ctx context.Context, in *GetFavoriteQuizzesRequest, opts ...grpc.CallOption) (*FavoriteQuizzesResponse, error)
 	RemoveFavoriteQuiz(ctx context.Context, in *RemoveFavoriteQuizRequest, opts ...grpc.CallOption) (*FavoriteQuizResponse, error)
+	// Challenges
+	ChallengeFriend(ctx context.Context, in *ChallengeFriendRequest, opts ...grpc.CallOption) (*ChallengeResponse, error)
+	CompleteChallenge(ctx context.Context, in *CompleteChallengeRequest, opts ...grpc.CallOption) (*ChallengeResponse, error)
+	// Challenges sent and received by the caller, newest first
+	GetChallenges(ctx context.Context, in *GetChallengesRequest, opts ...grpc.CallOption) (*ChallengesListResponse, error)
 }
 
 type authServiceClient struct {
@@ -165,6 +176,16 @@ func (c *authServiceClient) GetFriends(ctx context.Context, in *GetFriendsReques
 	return out, nil
 }
 
+func (c *authServiceClient) GetFriendOf(ctx context.Context, in *GetFriendOfRequest, opts ...grpc.CallOption) (*FriendOfResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FriendOfResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetFriendOf_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authServiceClient) AddFavoriteQuiz(ctx context.Context, in *AddFavoriteQuizRequest, opts ...grpc.CallOption) (*FavoriteQuizResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(FavoriteQuizResponse)
@@ -195,6 +216,36 @@ func (c *authServiceClient) RemoveFavoriteQuiz(ctx context.Context, in *RemoveFa
 	return out, nil
 }
 
+func (c *authServiceClient) ChallengeFriend(ctx context.Context, in *ChallengeFriendRequest, opts ...grpc.CallOption) (*ChallengeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChallengeResponse)
+	err := c.cc.Invoke(ctx, AuthService_ChallengeFriend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CompleteChallenge(ctx context.Context, in *CompleteChallengeRequest, opts ...grpc.CallOption) (*ChallengeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChallengeResponse)
+	err := c.cc.Invoke(ctx, AuthService_CompleteChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetChallenges(ctx context.Context, in *GetChallengesRequest, opts ...grpc.CallOption) (*ChallengesListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChallengesListResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetChallenges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations must embed UnimplementedAuthServiceServer
 // for forward compatibility.
@@ -212,10 +263,17 @@ type AuthServiceServer interface {
 	AddFriend(context.Context, *AddFriendRequest) (*FriendResponse, error)
 	RemoveFriend(context.Context, *RemoveFriendRequest) (*FriendResponse, error)
 	GetFriends(context.Context, *GetFriendsRequest) (*FriendsListResponse, error)
+	// Users who added the caller to their friends, used by quiz_service for friends-only quizzes
+	GetFriendOf(context.Context, *GetFriendOfRequest) (*FriendOfResponse, error)
 	// Quizzez
 	AddFavoriteQuiz(context.Context, *AddFavoriteQuizRequest) (*FavoriteQuizResponse, error)
 	GetFavoriteQuizzes(context.Context, *GetFavoriteQuizzesRequest) (*FavoriteQuizzesResponse, error)
 	RemoveFavoriteQuiz(context.Context, *RemoveFavoriteQuizRequest) (*FavoriteQuizResponse, error)
+	// Challenges
+	ChallengeFriend(context.Context, *ChallengeFriendRequest) (*ChallengeResponse, error)
+	CompleteChallenge(context.Context, *CompleteChallengeRequest) (*ChallengeResponse, error)
+	// Challenges sent and received by the caller, newest first
+	GetChallenges(context.Context, *GetChallengesRequest) (*ChallengesListResponse, error)
 	mustEmbedUnimplementedAuthServiceServer()
 }
 
@@ -256,6 +314,9 @@ func (UnimplementedAuthServiceServer) RemoveFriend(context.Context, *RemoveFrien
 func (UnimplementedAuthServiceServer) GetFriends(context.Context, *GetFriendsRequest) (*FriendsListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFriends not implemented")
 }
+func (UnimplementedAuthServiceServer) GetFriendOf(context.Context, *GetFriendOfRequest) (*FriendOfResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFriendOf not implemented")
+}
 func (UnimplementedAuthServiceServer) AddFavoriteQuiz(context.Context, *AddFavoriteQuizRequest) (*FavoriteQuizResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddFavoriteQuiz not implemented")
 }
@@ -265,6 +326,15 @@ func (UnimplementedAuthServiceServer) GetFavoriteQuizzes(context.Context, *GetFa
 func (UnimplementedAuthServiceServer) RemoveFavoriteQuiz(context.Context, *RemoveFavoriteQuizRequest) (*FavoriteQuizResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveFavoriteQuiz not implemented")
 }
+func (UnimplementedAuthServiceServer) ChallengeFriend(context.Context, *ChallengeFriendRequest) (*ChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChallengeFriend not implemented")
+}
+func (UnimplementedAuthServiceServer) CompleteChallenge(context.Context, *CompleteChallengeRequest) (*ChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteChallenge not implemented")
+}
+func (UnimplementedAuthServiceServer) GetChallenges(context.Context, *GetChallengesRequest) (*ChallengesListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChallenges not implemented")
+}
 func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
 func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
 
@@ -466,6 +536,24 @@ func _AuthService_GetFriends_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_GetFriendOf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFriendOfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetFriendOf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetFriendOf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetFriendOf(ctx, req.(*GetFriendOfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuthService_AddFavoriteQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddFavoriteQuizRequest)
 	if err := dec(in); err != nil {
@@ -520,6 +608,60 @@ func _AuthService_RemoveFavoriteQuiz_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_ChallengeFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChallengeFriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ChallengeFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ChallengeFriend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ChallengeFriend(ctx, req.(*ChallengeFriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CompleteChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CompleteChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CompleteChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CompleteChallenge(ctx, req.(*CompleteChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetChallenges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChallengesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetChallenges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetChallenges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetChallenges(ctx, req.(*GetChallengesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -567,6 +709,10 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFriends",
 			Handler:    _AuthService_GetFriends_Handler,
 		},
+		{
+			MethodName: "GetFriendOf",
+			Handler:    _AuthService_GetFriendOf_Handler,
+		},
 		{
 			MethodName: "AddFavoriteQuiz",
 			Handler:    _AuthService_AddFavoriteQuiz_Handler,
@@ -579,6 +725,18 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveFavoriteQuiz",
 			Handler:    _AuthService_RemoveFavoriteQuiz_Handler,
 		},
+		{
+			MethodName: "ChallengeFriend",
+			Handler:    _AuthService_ChallengeFriend_Handler,
+		},
+		{
+			MethodName: "CompleteChallenge",
+			Handler:    _AuthService_CompleteChallenge_Handler,
+		},
+		{
+			MethodName: "GetChallenges",
+			Handler:    _AuthService_GetChallenges_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/auth.proto",
@@ -19,13 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Statistics_UpdateStats_FullMethodName   = "/api.Statistics/UpdateStats"
-	Statistics_GetQuizStat_FullMethodName   = "/api.Statistics/GetQuizStat"
-	Statistics_ListQuizzes_FullMethodName   = "/api.Statistics/ListQuizzes"
-	Statistics_GetPlayerStat_FullMethodName = "/api.Statistics/GetPlayerStat"
-	Statistics_ListPlayers_FullMethodName   = "/api.Statistics/ListPlayers"
-	Statistics_GetAuthorStat_FullMethodName = "/api.Statistics/GetAuthorStat"
-	Statistics_ListAuthors_FullMethodName   = "/api.Statistics/ListAuthors"
+	Statistics_UpdateStats_FullMethodName        = "/api.Statistics/UpdateStats"
+	Statistics_ListPlayerSessions_FullMethodName = "/api.Statistics/ListPlayerSessions"
+	Statistics_ListQuizSessions_FullMethodName   = "/api.Statistics/ListQuizSessions"
+	Statistics_GetQuizStat_FullMethodName        = "/api.Statistics/GetQuizStat"
+	Statistics_ListQuizzes_FullMethodName        = "/api.Statistics/ListQuizzes"
+	Statistics_RateQuiz_FullMethodName           = "/api.Statistics/RateQuiz"
+	Statistics_ListQuizRatings_FullMethodName    = "/api.Statistics/ListQuizRatings"
+	Statistics_GetQuestionStats_FullMethodName   = "/api.Statistics/GetQuestionStats"
+	Statistics_GetPlayerStat_FullMethodName      = "/api.Statistics/GetPlayerStat"
+	Statistics_ListPlayers_FullMethodName        = "/api.Statistics/ListPlayers"
+	Statistics_GetAuthorStat_FullMethodName      = "/api.Statistics/GetAuthorStat"
+	Statistics_ListAuthors_FullMethodName        = "/api.Statistics/ListAuthors"
+	Statistics_RecordTeamResults_FullMethodName  = "/api.Statistics/RecordTeamResults"
+	Statistics_GetTeamStat_FullMethodName        = "/api.Statistics/GetTeamStat"
+	Statistics_ListTeams_FullMethodName          = "/api.Statistics/ListTeams"
+	Statistics_GetDailyStreak_FullMethodName     = "/api.Statistics/GetDailyStreak"
 )
 
 // StatisticsClient is the client API for Statistics service.
@@ -34,15 +43,36 @@ const (
 type StatisticsClient interface {
 	// Updating
 	UpdateStats(ctx context.Context, in *UpdateStatsRequest, opts ...grpc.CallOption) (*UpdateStatsResponse, error)
+	// Sessions
+	// Played sessions behind the statistics, newest first.
+	ListPlayerSessions(ctx context.Context, in *ListPlayerSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	ListQuizSessions(ctx context.Context, in *ListQuizSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
 	// Quizzes
 	GetQuizStat(ctx context.Context, in *GetQuizStatRequest, opts ...grpc.CallOption) (*GetQuizStatResponse, error)
 	ListQuizzes(ctx context.Context, in *ListQuizzesRequest, opts ...grpc.CallOption) (*ListQuizzesResponse, error)
+	// Ratings
+	// One rating per user per quiz, rating again replaces it. The user is taken from the bearer token.
+	RateQuiz(ctx context.Context, in *RateQuizRequest, opts ...grpc.CallOption) (*RateQuizResponse, error)
+	// Ratings with their reviews, the latest first.
+	ListQuizRatings(ctx context.Context, in *ListQuizRatingsRequest, opts ...grpc.CallOption) (*ListQuizRatingsResponse, error)
+	// Questions
+	// Per-question analytics of a quiz, only for its author. The user is taken from the bearer token.
+	GetQuestionStats(ctx context.Context, in *GetQuestionStatsRequest, opts ...grpc.CallOption) (*GetQuestionStatsResponse, error)
 	// Players
 	GetPlayerStat(ctx context.Context, in *GetPlayerStatRequest, opts ...grpc.CallOption) (*GetPlayerStatResponse, error)
 	ListPlayers(ctx context.Context, in *ListPlayersRequest, opts ...grpc.CallOption) (*ListPlayersResponse, error)
 	// Authors
 	GetAuthorStat(ctx context.Context, in *GetAuthorStatRequest, opts ...grpc.CallOption) (*GetAuthorStatResponse, error)
 	ListAuthors(ctx context.Context, in *ListAuthorsRequest, opts ...grpc.CallOption) (*ListAuthorsResponse, error)
+	// Teams
+	// Standings of a finished room game or team challenge, sent by quiz_service and auth_service.
+	// Not exposed over REST.
+	RecordTeamResults(ctx context.Context, in *RecordTeamResultsRequest, opts ...grpc.CallOption) (*RecordTeamResultsResponse, error)
+	GetTeamStat(ctx context.Context, in *GetTeamStatRequest, opts ...grpc.CallOption) (*GetTeamStatResponse, error)
+	ListTeams(ctx context.Context, in *ListTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error)
+	// Daily quiz
+	// Days in a row the player finished the quiz of the day, counted from the sessions sent to UpdateStats.
+	GetDailyStreak(ctx context.Context, in *GetDailyStreakRequest, opts ...grpc.CallOption) (*GetDailyStreakResponse, error)
 }
 
 type statisticsClient struct {
@@ -63,6 +93,26 @@ func (c *statisticsClient) UpdateStats(ctx context.Context, in *UpdateStatsReque
 	return out, nil
 }
 
+func (c *statisticsClient) ListPlayerSessions(ctx context.Context, in *ListPlayerSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, Statistics_ListPlayerSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statisticsClient) ListQuizSessions(ctx context.Context, in *ListQuizSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, Statistics_ListQuizSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *statisticsClient) GetQuizStat(ctx context.Context, in *GetQuizStatRequest, opts ...grpc.CallOption) (*GetQuizStatResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetQuizStatResponse)
@@ -83,6 +133,36 @@ func (c *statisticsClient) ListQuizzes(ctx context.Context, in *ListQuizzesReque
 	return out, nil
 }
 
+func (c *statisticsClient) RateQuiz(ctx context.Context, in *RateQuizRequest, opts ...grpc.CallOption) (*RateQuizResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RateQuizResponse)
+	err := c.cc.Invoke(ctx, Statistics_RateQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statisticsClient) ListQuizRatings(ctx context.Context, in *ListQuizRatingsRequest, opts ...grpc.CallOption) (*ListQuizRatingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQuizRatingsResponse)
+	err := c.cc.Invoke(ctx, Statistics_ListQuizRatings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statisticsClient) GetQuestionStats(ctx context.Context, in *GetQuestionStatsRequest, opts ...grpc.CallOption) (*GetQuestionStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQuestionStatsResponse)
+	err := c.cc.Invoke(ctx, Statistics_GetQuestionStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *statisticsClient) GetPlayerStat(ctx context.Context, in *GetPlayerStatRequest, opts ...grpc.CallOption) (*GetPlayerStatResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetPlayerStatResponse)
@@ -123,21 +203,82 @@ func (c *statisticsClient) ListAuthors(ctx context.Context, in *ListAuthorsReque
 	return out, nil
 }
 
+func (c *statisticsClient) RecordTeamResults(ctx context.Context, in *RecordTeamResultsRequest, opts ...grpc.CallOption) (*RecordTeamResultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordTeamResultsResponse)
+	err := c.cc.Invoke(ctx, Statistics_RecordTeamResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statisticsClient) GetTeamStat(ctx context.Context, in *GetTeamStatRequest, opts ...grpc.CallOption) (*GetTeamStatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamStatResponse)
+	err := c.cc.Invoke(ctx, Statistics_GetTeamStat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statisticsClient) ListTeams(ctx context.Context, in *ListTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTeamsResponse)
+	err := c.cc.Invoke(ctx, Statistics_ListTeams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statisticsClient) GetDailyStreak(ctx context.Context, in *GetDailyStreakRequest, opts ...grpc.CallOption) (*GetDailyStreakResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDailyStreakResponse)
+	err := c.cc.Invoke(ctx, Statistics_GetDailyStreak_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StatisticsServer is the server API for Statistics service.
 // All implementations must embed UnimplementedStatisticsServer
 // for forward compatibility.
 type StatisticsServer interface {
 	// Updating
 	UpdateStats(context.Context, *UpdateStatsRequest) (*UpdateStatsResponse, error)
+	// Sessions
+	// Played sessions behind the statistics, newest first.
+	ListPlayerSessions(context.Context, *ListPlayerSessionsRequest) (*ListSessionsResponse, error)
+	ListQuizSessions(context.Context, *ListQuizSessionsRequest) (*ListSessionsResponse, error)
 	// Quizzes
 	GetQuizStat(context.Context, *GetQuizStatRequest) (*GetQuizStatResponse, error)
 	ListQuizzes(context.Context, *ListQuizzesRequest) (*ListQuizzesResponse, error)
+	// Ratings
+	// One rating per user per quiz, rating again replaces it. The user is taken from the bearer token.
+	RateQuiz(context.Context, *RateQuizRequest) (*RateQuizResponse, error)
+	// Ratings with their reviews, the latest first.
+	ListQuizRatings(context.Context, *ListQuizRatingsRequest) (*ListQuizRatingsResponse, error)
+	// Questions
+	// Per-question analytics of a quiz, only for its author. The user is taken from the bearer token.
+	GetQuestionStats(context.Context, *GetQuestionStatsRequest) (*GetQuestionStatsResponse, error)
 	// Players
 	GetPlayerStat(context.Context, *GetPlayerStatRequest) (*GetPlayerStatResponse, error)
 	ListPlayers(context.Context, *ListPlayersRequest) (*ListPlayersResponse, error)
 	// Authors
 	GetAuthorStat(context.Context, *GetAuthorStatRequest) (*GetAuthorStatResponse, error)
 	ListAuthors(context.Context, *ListAuthorsRequest) (*ListAuthorsResponse, error)
+	// Teams
+	// Standings of a finished room game or team challenge, sent by quiz_service and auth_service.
+	// Not exposed over REST.
+	RecordTeamResults(context.Context, *RecordTeamResultsRequest) (*RecordTeamResultsResponse, error)
+	GetTeamStat(context.Context, *GetTeamStatRequest) (*GetTeamStatResponse, error)
+	ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error)
+	// Daily quiz
+	// Days in a row the player finished the quiz of the day, counted from the sessions sent to UpdateStats.
+	GetDailyStreak(context.Context, *GetDailyStreakRequest) (*GetDailyStreakResponse, error)
 	mustEmbedUnimplementedStatisticsServer()
 }
 
@@ -151,12 +292,27 @@ type UnimplementedStatisticsServer struct{}
 func (UnimplementedStatisticsServer) UpdateStats(context.Context, *UpdateStatsRequest) (*UpdateStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateStats not implemented")
 }
+func (UnimplementedStatisticsServer) ListPlayerSessions(context.Context, *ListPlayerSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlayerSessions not implemented")
+}
+func (UnimplementedStatisticsServer) ListQuizSessions(context.Context, *ListQuizSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuizSessions not implemented")
+}
 func (UnimplementedStatisticsServer) GetQuizStat(context.Context, *GetQuizStatRequest) (*GetQuizStatResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetQuizStat not implemented")
 }
 func (UnimplementedStatisticsServer) ListQuizzes(context.Context, *ListQuizzesRequest) (*ListQuizzesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListQuizzes not implemented")
 }
+func (UnimplementedStatisticsServer) RateQuiz(context.Context, *RateQuizRequest) (*RateQuizResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateQuiz not implemented")
+}
+func (UnimplementedStatisticsServer) ListQuizRatings(context.Context, *ListQuizRatingsRequest) (*ListQuizRatingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuizRatings not implemented")
+}
+func (UnimplementedStatisticsServer) GetQuestionStats(context.Context, *GetQuestionStatsRequest) (*GetQuestionStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuestionStats not implemented")
+}
 func (UnimplementedStatisticsServer) GetPlayerStat(context.Context, *GetPlayerStatRequest) (*GetPlayerStatResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerStat not implemented")
 }
@@ -169,6 +325,18 @@ func (UnimplementedStatisticsServer) GetAuthorStat(context.Context, *GetAuthorSt
 func (UnimplementedStatisticsServer) ListAuthors(context.Context, *ListAuthorsRequest) (*ListAuthorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAuthors not implemented")
 }
+func (UnimplementedStatisticsServer) RecordTeamResults(context.Context, *RecordTeamResultsRequest) (*RecordTeamResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordTeamResults not implemented")
+}
+func (UnimplementedStatisticsServer) GetTeamStat(context.Context, *GetTeamStatRequest) (*GetTeamStatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeamStat not implemented")
+}
+func (UnimplementedStatisticsServer) ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTeams not implemented")
+}
+func (UnimplementedStatisticsServer) GetDailyStreak(context.Context, *GetDailyStreakRequest) (*GetDailyStreakResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDailyStreak not implemented")
+}
 func (UnimplementedStatisticsServer) mustEmbedUnimplementedStatisticsServer() {}
 func (UnimplementedStatisticsServer) testEmbeddedByValue()                    {}
 
@@ -208,6 +376,42 @@ func _Statistics_UpdateStats_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Statistics_ListPlayerSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlayerSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatisticsServer).ListPlayerSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Statistics_ListPlayerSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatisticsServer).ListPlayerSessions(ctx, req.(*ListPlayerSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Statistics_ListQuizSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuizSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatisticsServer).ListQuizSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Statistics_ListQuizSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatisticsServer).ListQuizSessions(ctx, req.(*ListQuizSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Statistics_GetQuizStat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetQuizStatRequest)
 	if err := dec(in); err != nil {
@@ -244,6 +448,60 @@ func _Statistics_ListQuizzes_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Statistics_RateQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatisticsServer).RateQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Statistics_RateQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatisticsServer).RateQuiz(ctx, req.(*RateQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Statistics_ListQuizRatings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuizRatingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatisticsServer).ListQuizRatings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Statistics_ListQuizRatings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatisticsServer).ListQuizRatings(ctx, req.(*ListQuizRatingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Statistics_GetQuestionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuestionStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatisticsServer).GetQuestionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Statistics_GetQuestionStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatisticsServer).GetQuestionStats(ctx, req.(*GetQuestionStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Statistics_GetPlayerStat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPlayerStatRequest)
 	if err := dec(in); err != nil {
@@ -316,6 +574,78 @@ func _Statistics_ListAuthors_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Statistics_RecordTeamResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordTeamResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatisticsServer).RecordTeamResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Statistics_RecordTeamResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatisticsServer).RecordTeamResults(ctx, req.(*RecordTeamResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Statistics_GetTeamStat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamStatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatisticsServer).GetTeamStat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Statistics_GetTeamStat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatisticsServer).GetTeamStat(ctx, req.(*GetTeamStatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Statistics_ListTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatisticsServer).ListTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Statistics_ListTeams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatisticsServer).ListTeams(ctx, req.(*ListTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Statistics_GetDailyStreak_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDailyStreakRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatisticsServer).GetDailyStreak(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Statistics_GetDailyStreak_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatisticsServer).GetDailyStreak(ctx, req.(*GetDailyStreakRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Statistics_ServiceDesc is the grpc.ServiceDesc for Statistics service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -327,6 +657,14 @@ var Statistics_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateStats",
 			Handler:    _Statistics_UpdateStats_Handler,
 		},
+		{
+			MethodName: "ListPlayerSessions",
+			Handler:    _Statistics_ListPlayerSessions_Handler,
+		},
+		{
+			MethodName: "ListQuizSessions",
+			Handler:    _Statistics_ListQuizSessions_Handler,
+		},
 		{
 			MethodName: "GetQuizStat",
 			Handler:    _Statistics_GetQuizStat_Handler,
@@ -335,6 +673,18 @@ var Statistics_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListQuizzes",
 			Handler:    _Statistics_ListQuizzes_Handler,
 		},
+		{
+			MethodName: "RateQuiz",
+			Handler:    _Statistics_RateQuiz_Handler,
+		},
+		{
+			MethodName: "ListQuizRatings",
+			Handler:    _Statistics_ListQuizRatings_Handler,
+		},
+		{
+			MethodName: "GetQuestionStats",
+			Handler:    _Statistics_GetQuestionStats_Handler,
+		},
 		{
 			MethodName: "GetPlayerStat",
 			Handler:    _Statistics_GetPlayerStat_Handler,
@@ -351,6 +701,22 @@ var Statistics_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListAuthors",
 			Handler:    _Statistics_ListAuthors_Handler,
 		},
+		{
+			MethodName: "RecordTeamResults",
+			Handler:    _Statistics_RecordTeamResults_Handler,
+		},
+		{
+			MethodName: "GetTeamStat",
+			Handler:    _Statistics_GetTeamStat_Handler,
+		},
+		{
+			MethodName: "ListTeams",
+			Handler:    _Statistics_ListTeams_Handler,
+		},
+		{
+			MethodName: "GetDailyStreak",
+			Handler:    _Statistics_GetDailyStreak_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/stat.proto",
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+
+	"api_gateway/gen/quiz_service"
+
+	"golang.org/x/net/websocket"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// RoomsHandler переводит WebSocket в стрим RoomService.Play: каждый текстовый кадр -
+// RoomCommand в JSON, в ответ приходят RoomEvent в JSON. Браузер не может передать
+// заголовок при открытии WebSocket, поэтому токен можно передать параметром ?token=.
+func RoomsHandler(client quiz_service.RoomServiceClient) http.Handler {
+	return websocket.Server{Handler: func(ws *websocket.Conn) {
+		defer ws.Close()
+		r := ws.Request()
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+
+		token := r.URL.Query().Get("token")
+		if token == "" {
+			token = r.Header.Get("Authorization")
+		}
+		if token != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", token)
+		}
+		stream, err := client.Play(ctx)
+		if err != nil {
+			sendRoomError(ws, err)
+			return
+		}
+
+		// События из сервиса в браузер. Ошибка стрима уходит последним кадром,
+		// после чего соединение закрывается и цикл чтения ниже завершается.
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			defer ws.Close()
+			for {
+				event, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					return
+				}
+				if err != nil {
+					sendRoomError(ws, err)
+					return
+				}
+				data, err := protojson.Marshal(event)
+				if err != nil {
+					log.Printf("Room event encoding error: %v", err)
+					return
+				}
+				if err = websocket.Message.Send(ws, string(data)); err != nil {
+					return
+				}
+			}
+		}()
+
+		for {
+			var msg string
+			if err := websocket.Message.Receive(ws, &msg); err != nil {
+				break
+			}
+			var cmd quiz_service.RoomCommand
+			if err := protojson.Unmarshal([]byte(msg), &cmd); err != nil {
+				sendRoomError(ws, err)
+				continue
+			}
+			if err := stream.Send(&cmd); err != nil {
+				break
+			}
+		}
+		// Браузер ушел: сервис должен успеть убрать игрока из комнаты
+		stream.CloseSend()
+		<-done
+	}}
+}
+
+func sendRoomError(ws *websocket.Conn, err error) {
+	event := &quiz_service.RoomEvent{Event: &quiz_service.RoomEvent_Error{
+		Error: &quiz_service.RoomErrorEvent{Message: status.Convert(err).Message()},
+	}}
+	data, _ := protojson.Marshal(event)
+	websocket.Message.Send(ws, string(data))
+}
@@ -10,7 +10,9 @@ import (
 	"eazy-quizy-auth/pkg/interceptors"
 	"eazy-quizy-auth/pkg/logger"
 	"eazy-quizy-auth/pkg/postgresql/database"
+	quizapi "eazy-quizy-auth/pkg/quizapi/v1"
 	"eazy-quizy-auth/pkg/redis"
+	statapi "eazy-quizy-auth/pkg/statapi/v1"
 	"net"
 	"os"
 	"os/signal"
@@ -18,6 +20,7 @@ import (
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -62,14 +65,30 @@ func (a *Application) Run(ctx context.Context) {
 	userRepo := repository.NewUserRepository(db.DB)
 	friendRepo := repository.NewFriendRepository(db.DB)
 	quizzesRepo := repository.NewQuizzesRepo(db.DB)
+	challengeRepo := repository.NewChallengeRepository(db.DB)
+
+	// Счета для вызовов берутся из сессий quiz_service
+	quizConn, err := grpc.NewClient(cfg.QuizAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		l.Fatal("failed to connect to quiz service", zap.Error(err))
+	}
+	defer quizConn.Close()
+
+	// Итоги командных вызовов уходят в историю команд stat_service
+	statConn, err := grpc.NewClient(cfg.StatAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		l.Fatal("failed to connect to stat service", zap.Error(err))
+	}
+	defer statConn.Close()
 
 	jwtService := service.NewJWTService(*userRepo, redisClient, &cfg.JWT, l)
 
 	authService := service.NewAuthService(*userRepo, jwtService, l)
 	friendService := service.NewFriendService(*friendRepo, *userRepo, l)
 	quizzesService := service.NewQuizzesService(*quizzesRepo, l)
+	challengeService := service.NewChallengeService(*challengeRepo, *friendRepo, *userRepo, quizapi.NewQuizServiceClient(quizConn), statapi.NewStatisticsClient(statConn), cfg.Challenges.TTL, l)
 
-	authController := controller.NewAuthController(authService, friendService, quizzesService, l)
+	authController := controller.NewAuthController(authService, friendService, quizzesService, challengeService, l)
 
 	authInterceptor := interceptors.NewAuthInterceptor(authService, l)
 
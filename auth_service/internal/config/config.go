@@ -8,10 +8,18 @@ import (
 )
 
 type Config struct {
-	GRPCPort string      `yaml:"grpcPort" json:"grpcPort"`
-	DB       DBConfig    `yaml:"db" json:"db"`
-	Redis    RedisConfig `yaml:"redis" json:"redis"`
-	JWT      JWTConfig   `yaml:"jwt" json:"jwt"`
+	GRPCPort   string          `yaml:"grpcPort" json:"grpcPort"`
+	QuizAddr   string          `yaml:"quizAddr" json:"quizAddr"`
+	StatAddr   string          `yaml:"statAddr" json:"statAddr"`
+	DB         DBConfig        `yaml:"db" json:"db"`
+	Redis      RedisConfig     `yaml:"redis" json:"redis"`
+	JWT        JWTConfig       `yaml:"jwt" json:"jwt"`
+	Challenges ChallengeConfig `yaml:"challenges" json:"challenges"`
+}
+
+type ChallengeConfig struct {
+	// Сколько у друга времени, чтобы ответить на вызов
+	TTL time.Duration `yaml:"ttl" json:"ttl"`
 }
 
 type RedisConfig struct {
@@ -19,19 +19,21 @@ import (
 
 type AuthController struct {
 	v1.UnimplementedAuthServiceServer
-	authService    service.AuthService
-	friendService  service.FriendService
-	quizzesService service.QuizzesService
+	authService      service.AuthService
+	friendService    service.FriendService
+	quizzesService   service.QuizzesService
+	challengeService service.ChallengeService
 
 	l *logger.Logger
 }
 
-func NewAuthController(authService service.AuthService, friendService service.FriendService, quizzesService service.QuizzesService, l *logger.Logger) *AuthController {
+func NewAuthController(authService service.AuthService, friendService service.FriendService, quizzesService service.QuizzesService, challengeService service.ChallengeService, l *logger.Logger) *AuthController {
 	return &AuthController{
-		authService:    authService,
-		friendService:  friendService,
-		quizzesService: quizzesService,
-		l:              l,
+		authService:      authService,
+		friendService:    friendService,
+		quizzesService:   quizzesService,
+		challengeService: challengeService,
+		l:                l,
 	}
 }
 
@@ -269,6 +271,22 @@ func (c *AuthController) GetFriends(ctx context.Context, req *v1.GetFriendsReque
 	return &v1.FriendsListResponse{Friends: protoFriends}, nil
 }
 
+func (c *AuthController) GetFriendOf(ctx context.Context, req *v1.GetFriendOfRequest) (*v1.FriendOfResponse, error) {
+	user, ok := utils.GetUser(ctx)
+	if !ok {
+		c.l.Warn("user not found in context")
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	usernames, err := c.friendService.GetFriendOf(ctx, user.ID)
+	if err != nil {
+		c.l.Error("Failed to get friend of", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get friend of")
+	}
+
+	return &v1.FriendOfResponse{Usernames: usernames}, nil
+}
+
 func (c *AuthController) AddFavoriteQuiz(ctx context.Context, req *v1.AddFavoriteQuizRequest) (*v1.FavoriteQuizResponse, error) {
 	userID, ok := utils.GetUser(ctx)
 	if !ok {
@@ -312,6 +330,84 @@ func (c *AuthController) RemoveFavoriteQuiz(ctx context.Context, req *v1.RemoveF
 	return &v1.FavoriteQuizResponse{Success: true, Message: "Quiz removed from favorites"}, nil
 }
 
+func (c *AuthController) ChallengeFriend(ctx context.Context, req *v1.ChallengeFriendRequest) (*v1.ChallengeResponse, error) {
+	user, ok := utils.GetUser(ctx)
+	if !ok {
+		c.l.Warn("user not found in context")
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+	if req.FriendId == "" || req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "friend_id and session_id are required")
+	}
+
+	var teams *entity.ChallengeTeams
+	if req.Team != "" || req.OpponentTeam != "" || len(req.Teammates) > 0 || len(req.Opponents) > 0 {
+		teams = &entity.ChallengeTeams{
+			Team:         req.Team,
+			Teammates:    req.Teammates,
+			OpponentTeam: req.OpponentTeam,
+			Opponents:    req.Opponents,
+			Scoring:      entity.ScoringAverage,
+		}
+		if req.TeamScoring == v1.ChallengeTeamScoring_CHALLENGE_TEAM_SCORING_SUM {
+			teams.Scoring = entity.ScoringSum
+		}
+	}
+
+	challenge, err := c.challengeService.ChallengeFriend(ctx, user, req.QuizId, req.FriendId, req.SessionId, teams)
+	if err != nil {
+		return nil, challengeError(err)
+	}
+
+	c.l.Info("Challenge sent", zap.Uint64("user_id", user.ID), zap.String("friend_id", req.FriendId), zap.Uint64("challenge_id", challenge.ID))
+
+	return &v1.ChallengeResponse{Challenge: convertChallengeToProto(challenge)}, nil
+}
+
+func (c *AuthController) CompleteChallenge(ctx context.Context, req *v1.CompleteChallengeRequest) (*v1.ChallengeResponse, error) {
+	user, ok := utils.GetUser(ctx)
+	if !ok {
+		c.l.Warn("user not found in context")
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+	challengeID, err := strconv.ParseUint(req.ChallengeId, 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid challenge_id")
+	}
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+
+	challenge, err := c.challengeService.CompleteChallenge(ctx, user, challengeID, req.SessionId)
+	if err != nil {
+		return nil, challengeError(err)
+	}
+
+	c.l.Info("Challenge completed", zap.Uint64("user_id", user.ID), zap.Uint64("challenge_id", challenge.ID))
+
+	return &v1.ChallengeResponse{Challenge: convertChallengeToProto(challenge)}, nil
+}
+
+func (c *AuthController) GetChallenges(ctx context.Context, req *v1.GetChallengesRequest) (*v1.ChallengesListResponse, error) {
+	user, ok := utils.GetUser(ctx)
+	if !ok {
+		c.l.Warn("user not found in context")
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	challenges, err := c.challengeService.GetChallenges(ctx, user.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get challenges")
+	}
+
+	var protoChallenges []*v1.Challenge
+	for _, challenge := range challenges {
+		protoChallenges = append(protoChallenges, convertChallengeToProto(challenge))
+	}
+
+	return &v1.ChallengesListResponse{Challenges: protoChallenges}, nil
+}
+
 func (c *AuthController) Logout(ctx context.Context, req *v1.LogoutRequest) (*v1.LogoutResponse, error) {
 	if err := c.authService.Logout(ctx, req.Token); err != nil {
 		return nil, status.Error(codes.Internal, "logout failed")
@@ -331,6 +427,65 @@ func convertUserToProto(user *entity.User) *v1.UserResponse {
 	}
 }
 
+// challengeError переводит ошибки вызовов в коды gRPC
+func challengeError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrFriendNotFound), errors.Is(err, entity.ErrChallengeNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrCannotChallengeYourself), errors.Is(err, service.ErrInvalidSession), errors.Is(err, service.ErrInvalidTeams):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrNotFriends), errors.Is(err, service.ErrOperationNotAllowed):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, entity.ErrChallengeExists), errors.Is(err, entity.ErrChallengePlayed):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, entity.ErrChallengeNotActive):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, "failed to process challenge")
+	}
+}
+
+var challengeStatuses = map[entity.ChallengeStatus]v1.ChallengeStatus{
+	entity.ChallengePending:   v1.ChallengeStatus_CHALLENGE_STATUS_PENDING,
+	entity.ChallengeCompleted: v1.ChallengeStatus_CHALLENGE_STATUS_COMPLETED,
+	entity.ChallengeExpired:   v1.ChallengeStatus_CHALLENGE_STATUS_EXPIRED,
+}
+
+func convertChallengeToProto(challenge *entity.Challenge) *v1.Challenge {
+	result := &v1.Challenge{
+		Id:              strconv.FormatUint(challenge.ID, 10),
+		QuizId:          challenge.QuizID,
+		Challenger:      challenge.Challenger,
+		Opponent:        challenge.Opponent,
+		ChallengerScore: challenge.ChallengerScore,
+		OpponentScore:   challenge.OpponentScore,
+		Winner:          challenge.Winner,
+		Status:          challengeStatuses[challenge.Status],
+		CreatedAt:       timestamppb.New(challenge.CreatedAt),
+		ExpiresAt:       timestamppb.New(challenge.ExpiresAt),
+	}
+	if challenge.CompletedAt != nil {
+		result.CompletedAt = timestamppb.New(*challenge.CompletedAt)
+	}
+	if challenge.IsTeam() {
+		result.Team = challenge.Team
+		result.OpponentTeam = challenge.OpponentTeam
+		result.WinnerTeam = challenge.WinnerTeam
+		if challenge.TeamScoring == entity.ScoringSum {
+			result.TeamScoring = v1.ChallengeTeamScoring_CHALLENGE_TEAM_SCORING_SUM
+		}
+	}
+	for _, member := range challenge.Members {
+		result.Members = append(result.Members, &v1.ChallengeMember{
+			Username: member.Username,
+			Opponent: member.Opponent,
+			Score:    member.Score,
+		})
+	}
+
+	return result
+}
+
 func isValidEmail(email string) bool {
 	return strings.Contains(email, "@") && strings.Contains(email, ".")
 }
@@ -0,0 +1,136 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+type ChallengeStatus string
+
+const (
+	ChallengePending   ChallengeStatus = "pending"
+	ChallengeCompleted ChallengeStatus = "completed"
+	ChallengeExpired   ChallengeStatus = "expired"
+)
+
+// TeamScoring - как очки участников складываются в счет команды
+type TeamScoring string
+
+const (
+	ScoringAverage TeamScoring = "average"
+	ScoringSum     TeamScoring = "sum"
+)
+
+// Challenge - вызов другу сыграть тот же квиз. Счет вызвавшего известен сразу,
+// счет друга и победитель появляются, когда друг сыграет. В командном вызове
+// счет стороны складывается из сыгравших участников, а итог подводится,
+// когда сыграют все.
+type Challenge struct {
+	ID              uint64          `json:"id"`
+	QuizID          string          `json:"quiz_id"`
+	ChallengerID    uint64          `json:"challenger_id"`
+	Challenger      string          `json:"challenger"`
+	OpponentID      uint64          `json:"opponent_id"`
+	Opponent        string          `json:"opponent"`
+	ChallengerScore float32         `json:"challenger_score"`
+	OpponentScore   *float32        `json:"opponent_score,omitempty"`
+	Winner          string          `json:"winner,omitempty"`
+	Status          ChallengeStatus `json:"status"`
+	CreatedAt       time.Time       `json:"created_at"`
+	ExpiresAt       time.Time       `json:"expires_at"`
+	CompletedAt     *time.Time      `json:"completed_at,omitempty"`
+
+	Team         string            `json:"team,omitempty"`
+	OpponentTeam string            `json:"opponent_team,omitempty"`
+	TeamScoring  TeamScoring       `json:"team_scoring,omitempty"`
+	WinnerTeam   string            `json:"winner_team,omitempty"`
+	Members      []ChallengeMember `json:"members"`
+}
+
+// ChallengeMember - участник вызова. Opponent отмечает сторону вызванного друга.
+type ChallengeMember struct {
+	UserID   uint64   `json:"user_id"`
+	Username string   `json:"username"`
+	Opponent bool     `json:"opponent"`
+	Score    *float32 `json:"score,omitempty"`
+}
+
+// ChallengeTeams - состав команд при отправке командного вызова
+type ChallengeTeams struct {
+	Team         string
+	Teammates    []string
+	OpponentTeam string
+	Opponents    []string
+	Scoring      TeamScoring
+}
+
+// IsTeam отличает командный вызов от вызова один на один
+func (c *Challenge) IsTeam() bool {
+	return c.Team != ""
+}
+
+// Member возвращает участника по ID или nil
+func (c *Challenge) Member(userID uint64) *ChallengeMember {
+	for i := range c.Members {
+		if c.Members[i].UserID == userID {
+			return &c.Members[i]
+		}
+	}
+	return nil
+}
+
+// Tally пересчитывает счет сторон по сыгравшим участникам и, если сыграли все,
+// определяет победителя. Возвращает true, когда вызов завершен.
+func (c *Challenge) Tally() bool {
+	var totals, played, members [2]float32
+	for _, member := range c.Members {
+		side := 0
+		if member.Opponent {
+			side = 1
+		}
+		members[side]++
+		if member.Score != nil {
+			totals[side] += *member.Score
+			played[side]++
+		}
+	}
+	var scores [2]float32
+	for side := range scores {
+		scores[side] = totals[side]
+		if c.TeamScoring != ScoringSum && played[side] > 0 {
+			scores[side] /= played[side]
+		}
+	}
+	c.ChallengerScore = scores[0]
+	c.OpponentScore = nil
+	if played[1] > 0 {
+		c.OpponentScore = &scores[1]
+	}
+	c.Winner, c.WinnerTeam = "", ""
+	if played != members {
+		return false
+	}
+	winner := -1
+	switch {
+	case scores[0] > scores[1]:
+		winner = 0
+	case scores[0] < scores[1]:
+		winner = 1
+	}
+	if winner < 0 {
+		return true
+	}
+	if c.IsTeam() {
+		c.WinnerTeam = [2]string{c.Team, c.OpponentTeam}[winner]
+	} else {
+		c.Winner = [2]string{c.Challenger, c.Opponent}[winner]
+	}
+	return true
+}
+
+var (
+	ErrChallengeNotFound  = errors.New("challenge not found")
+	ErrChallengeExists    = errors.New("challenge with this session already sent")
+	ErrChallengeNotActive = errors.New("challenge is already completed or expired")
+	ErrChallengePlayed    = errors.New("challenge is already played by this user")
+)
@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func score(v float32) *float32 {
+	return &v
+}
+
+func TestChallengeTallyOneOnOne(t *testing.T) {
+	c := &Challenge{
+		Challenger: "alice",
+		Opponent:   "bob",
+		Members: []ChallengeMember{
+			{UserID: 1, Username: "alice", Score: score(80)},
+			{UserID: 2, Username: "bob", Opponent: true},
+		},
+	}
+	assert.False(t, c.Tally())
+	assert.Equal(t, float32(80), c.ChallengerScore)
+	assert.Nil(t, c.OpponentScore)
+
+	c.Members[1].Score = score(90)
+	assert.True(t, c.Tally())
+	assert.Equal(t, "bob", c.Winner)
+	assert.Empty(t, c.WinnerTeam)
+
+	c.Members[1].Score = score(80)
+	assert.True(t, c.Tally())
+	assert.Empty(t, c.Winner, "a draw has no winner")
+}
+
+func TestChallengeTallyTeams(t *testing.T) {
+	c := &Challenge{
+		Team:         "owls",
+		OpponentTeam: "foxes",
+		TeamScoring:  ScoringAverage,
+		Members: []ChallengeMember{
+			{UserID: 1, Score: score(90)},
+			{UserID: 2, Score: score(30)},
+			{UserID: 3, Opponent: true, Score: score(70)},
+			{UserID: 4, Opponent: true},
+		},
+	}
+	assert.False(t, c.Tally(), "the challenge waits for every member")
+	assert.Equal(t, float32(60), c.ChallengerScore)
+	assert.Equal(t, float32(70), *c.OpponentScore)
+	assert.Empty(t, c.WinnerTeam)
+
+	c.Members[3].Score = score(40)
+	assert.True(t, c.Tally())
+	assert.Equal(t, float32(55), *c.OpponentScore)
+	assert.Equal(t, "owls", c.WinnerTeam)
+	assert.Empty(t, c.Winner)
+
+	c.TeamScoring = ScoringSum
+	assert.True(t, c.Tally())
+	assert.Equal(t, float32(120), c.ChallengerScore)
+	assert.Equal(t, float32(110), *c.OpponentScore)
+	assert.Equal(t, "owls", c.WinnerTeam)
+}
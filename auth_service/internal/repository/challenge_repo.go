@@ -0,0 +1,284 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"eazy-quizy-auth/internal/entity"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type ChallengeRepository struct {
+	db *sql.DB
+}
+
+func NewChallengeRepository(db *sql.DB) *ChallengeRepository {
+	return &ChallengeRepository{db: db}
+}
+
+// Статус считается в момент чтения: просроченный вызов никто не обновляет
+const challengeColumns = `
+	c.id, c.quiz_id, c.challenger_id, cu.username, c.opponent_id, ou.username,
+	c.challenger_score, c.opponent_score, COALESCE(wu.username, ''),
+	CASE WHEN c.completed_at IS NOT NULL THEN 'completed' WHEN c.expires_at <= NOW() THEN 'expired' ELSE 'pending' END,
+	c.created_at, c.expires_at, c.completed_at,
+	c.team, c.opponent_team, c.team_scoring, c.winner_team
+	FROM challenges c
+	JOIN users cu ON cu.id = c.challenger_id
+	JOIN users ou ON ou.id = c.opponent_id
+	LEFT JOIN users wu ON wu.id = c.winner_id`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanChallenge(row rowScanner) (*entity.Challenge, error) {
+	var c entity.Challenge
+	var opponentScore sql.NullFloat64
+	var completedAt sql.NullTime
+	err := row.Scan(&c.ID, &c.QuizID, &c.ChallengerID, &c.Challenger, &c.OpponentID, &c.Opponent,
+		&c.ChallengerScore, &opponentScore, &c.Winner, &c.Status,
+		&c.CreatedAt, &c.ExpiresAt, &completedAt,
+		&c.Team, &c.OpponentTeam, &c.TeamScoring, &c.WinnerTeam)
+	if err != nil {
+		return nil, err
+	}
+	if opponentScore.Valid {
+		score := float32(opponentScore.Float64)
+		c.OpponentScore = &score
+	}
+	if completedAt.Valid {
+		c.CompletedAt = &completedAt.Time
+	}
+	return &c, nil
+}
+
+// loadMembers дополняет вызовы участниками: сначала сторона вызвавшего, потом сторона друга
+func (r *ChallengeRepository) loadMembers(ctx context.Context, challenges ...*entity.Challenge) error {
+	if len(challenges) == 0 {
+		return nil
+	}
+	byID := make(map[uint64]*entity.Challenge, len(challenges))
+	ids := make([]int64, 0, len(challenges))
+	for _, c := range challenges {
+		byID[c.ID] = c
+		ids = append(ids, int64(c.ID))
+	}
+	query := `SELECT m.challenge_id, m.user_id, u.username, m.opponent, m.score
+		FROM challenge_members m
+		JOIN users u ON u.id = m.user_id
+		WHERE m.challenge_id = ANY($1)
+		ORDER BY m.challenge_id, m.opponent, m.sender DESC, u.username`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("can't get challenge members: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var challengeID uint64
+		var member entity.ChallengeMember
+		var score sql.NullFloat64
+		if err = rows.Scan(&challengeID, &member.UserID, &member.Username, &member.Opponent, &score); err != nil {
+			return fmt.Errorf("can't scan challenge member: %w", err)
+		}
+		if score.Valid {
+			value := float32(score.Float64)
+			member.Score = &value
+		}
+		if c := byID[challengeID]; c != nil {
+			c.Members = append(c.Members, member)
+		}
+	}
+	return rows.Err()
+}
+
+// CreateChallenge сохраняет вызов вместе с участниками. Участник с Score - сам вызвавший,
+// его сессия sessionID уже сыграна.
+func (r *ChallengeRepository) CreateChallenge(ctx context.Context, challenge *entity.Challenge, sessionID string, ttl time.Duration) (uint64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("can't create challenge: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `INSERT INTO challenges (quiz_id, challenger_id, opponent_id, challenger_session, challenger_score, expires_at,
+			team, opponent_team, team_scoring)
+		VALUES ($1, $2, $3, $4, $5, NOW() + make_interval(secs => $6), $7, $8, $9)
+		RETURNING id`
+
+	var id uint64
+	err = tx.QueryRowContext(ctx, query, challenge.QuizID, challenge.ChallengerID, challenge.OpponentID, sessionID,
+		challenge.ChallengerScore, ttl.Seconds(), challenge.Team, challenge.OpponentTeam, challenge.TeamScoring).Scan(&id)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return 0, entity.ErrChallengeExists
+		}
+		return 0, fmt.Errorf("can't create challenge: %w", err)
+	}
+
+	memberQuery := `INSERT INTO challenge_members (challenge_id, user_id, opponent, sender, session_id, score, played_at)
+		VALUES ($1, $2, $3, $4, $5, $6, CASE WHEN $4 THEN NOW() END)`
+	for _, member := range challenge.Members {
+		sender := member.Score != nil
+		var session sql.NullString
+		if sender {
+			session = sql.NullString{String: sessionID, Valid: true}
+		}
+		if _, err = tx.ExecContext(ctx, memberQuery, id, member.UserID, member.Opponent, sender, session, member.Score); err != nil {
+			return 0, fmt.Errorf("can't add challenge member: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("can't create challenge: %w", err)
+	}
+	return id, nil
+}
+
+func (r *ChallengeRepository) GetChallenge(ctx context.Context, id uint64) (*entity.Challenge, error) {
+	query := `SELECT ` + challengeColumns + ` WHERE c.id = $1`
+
+	challenge, err := scanChallenge(r.db.QueryRowContext(ctx, query, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, entity.ErrChallengeNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("can't get challenge: %w", err)
+	}
+	if err = r.loadMembers(ctx, challenge); err != nil {
+		return nil, err
+	}
+
+	return challenge, nil
+}
+
+// CompleteChallenge записывает результат участника, пока вызов не завершен и не просрочен,
+// и пересчитывает счет сторон. Строка вызова блокируется, чтобы участники, сыгравшие
+// одновременно, не подвели итог дважды или не пропустили его.
+func (r *ChallengeRepository) CompleteChallenge(ctx context.Context, id uint64, userID uint64, sessionID string, score float32) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("can't complete challenge: %w", err)
+	}
+	defer tx.Rollback()
+
+	challenge, err := scanChallenge(tx.QueryRowContext(ctx, `SELECT `+challengeColumns+` WHERE c.id = $1 FOR UPDATE OF c`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.ErrChallengeNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("can't complete challenge: %w", err)
+	}
+	if challenge.Status != entity.ChallengePending {
+		return entity.ErrChallengeNotActive
+	}
+
+	result, err := tx.ExecContext(ctx, `UPDATE challenge_members
+		SET session_id = $3, score = $4, played_at = NOW()
+		WHERE challenge_id = $1 AND user_id = $2 AND session_id IS NULL`, id, userID, sessionID, score)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return entity.ErrChallengeExists
+		}
+		return fmt.Errorf("can't complete challenge: %w", err)
+	}
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("can't complete challenge: %w", err)
+	}
+	if updated == 0 {
+		return entity.ErrChallengePlayed
+	}
+
+	// Участники читаются внутри транзакции, чтобы учесть только что записанный результат
+	members, err := tx.QueryContext(ctx, `SELECT user_id, opponent, score FROM challenge_members WHERE challenge_id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("can't get challenge members: %w", err)
+	}
+	for members.Next() {
+		var member entity.ChallengeMember
+		var memberScore sql.NullFloat64
+		if err = members.Scan(&member.UserID, &member.Opponent, &memberScore); err != nil {
+			members.Close()
+			return fmt.Errorf("can't scan challenge member: %w", err)
+		}
+		if memberScore.Valid {
+			value := float32(memberScore.Float64)
+			member.Score = &value
+		}
+		challenge.Members = append(challenge.Members, member)
+	}
+	members.Close()
+	if err = members.Err(); err != nil {
+		return fmt.Errorf("can't get challenge members: %w", err)
+	}
+
+	done := challenge.Tally()
+	// winner_id ставится только в вызове один на один
+	var winnerID *uint64
+	switch challenge.Winner {
+	case "":
+	case challenge.Challenger:
+		winnerID = &challenge.ChallengerID
+	default:
+		winnerID = &challenge.OpponentID
+	}
+	var opponentSession *string
+	if userID == challenge.OpponentID {
+		opponentSession = &sessionID
+	}
+	_, err = tx.ExecContext(ctx, `UPDATE challenges
+		SET challenger_score = $2, opponent_score = $3, winner_id = $4, winner_team = $5,
+			opponent_session = COALESCE($6, opponent_session),
+			completed_at = CASE WHEN $7 THEN NOW() END
+		WHERE id = $1`,
+		id, challenge.ChallengerScore, challenge.OpponentScore, winnerID, challenge.WinnerTeam, opponentSession, done)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return entity.ErrChallengeExists
+		}
+		return fmt.Errorf("can't complete challenge: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("can't complete challenge: %w", err)
+	}
+	return nil
+}
+
+// GetChallenges возвращает вызовы, в которых участвует пользователь
+func (r *ChallengeRepository) GetChallenges(ctx context.Context, userID uint64) ([]*entity.Challenge, error) {
+	query := `SELECT ` + challengeColumns + `
+		WHERE c.id IN (SELECT challenge_id FROM challenge_members WHERE user_id = $1)
+		ORDER BY c.created_at DESC, c.id DESC`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("can't get challenges: %w", err)
+	}
+	defer rows.Close()
+
+	var challenges []*entity.Challenge
+	for rows.Next() {
+		challenge, err := scanChallenge(rows)
+		if err != nil {
+			return nil, fmt.Errorf("can't scan challenge: %w", err)
+		}
+		challenges = append(challenges, challenge)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("can't get challenges: %w", err)
+	}
+	if err = r.loadMembers(ctx, challenges...); err != nil {
+		return nil, err
+	}
+
+	return challenges, nil
+}
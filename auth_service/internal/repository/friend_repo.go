@@ -57,6 +57,28 @@ func (r *FriendRepository) GetFriendIDs(ctx context.Context, userID uint64) ([]s
 	return friendIDs, nil
 }
 
+// GetFriendOfUsernames возвращает имена пользователей, добавивших userID в друзья
+func (r *FriendRepository) GetFriendOfUsernames(ctx context.Context, userID uint64) ([]string, error) {
+	query := `SELECT u.username FROM friends f JOIN users u ON u.id = f.user_id WHERE f.friend_id = $1`
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("can't get friend of: %w", err)
+	}
+	defer rows.Close()
+
+	var usernames []string
+
+	for rows.Next() {
+		var username string
+		if err := rows.Scan(&username); err != nil {
+			return nil, err
+		}
+		usernames = append(usernames, username)
+	}
+
+	return usernames, rows.Err()
+}
+
 func (r *FriendRepository) CheckFriendship(ctx context.Context, userID uint64, friendID uint64) bool {
 	query := `
         SELECT COUNT(*) 
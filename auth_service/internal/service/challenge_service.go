@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+	"eazy-quizy-auth/internal/entity"
+	"eazy-quizy-auth/internal/repository"
+	"eazy-quizy-auth/pkg/logger"
+	quizapi "eazy-quizy-auth/pkg/quizapi/v1"
+	statapi "eazy-quizy-auth/pkg/statapi/v1"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type ChallengeService interface {
+	ChallengeFriend(ctx context.Context, user *entity.User, quizID string, friendID string, sessionID string, teams *entity.ChallengeTeams) (*entity.Challenge, error)
+	CompleteChallenge(ctx context.Context, user *entity.User, challengeID uint64, sessionID string) (*entity.Challenge, error)
+	GetChallenges(ctx context.Context, userID uint64) ([]*entity.Challenge, error)
+}
+
+// Sessions проверяет результаты игровых сессий в quiz_service
+type Sessions interface {
+	GetSessionResult(context.Context, *quizapi.GetSessionResultRequest, ...grpc.CallOption) (*quizapi.GetSessionResultResponse, error)
+}
+
+// TeamStats принимает итоги командных вызовов в stat_service
+type TeamStats interface {
+	RecordTeamResults(context.Context, *statapi.RecordTeamResultsRequest, ...grpc.CallOption) (*statapi.RecordTeamResultsResponse, error)
+}
+
+// Ограничения командных вызовов
+const (
+	maxTeamMembers   = 10
+	maxTeamName      = 32
+	teamStatsTimeout = 5 * time.Second
+)
+
+type challengeService struct {
+	challengeRepo repository.ChallengeRepository
+	friendRepo    repository.FriendRepository
+	userRepo      repository.UserRepository
+	sessions      Sessions
+	stats         TeamStats
+	ttl           time.Duration
+	l             *logger.Logger
+}
+
+func NewChallengeService(challengeRepo repository.ChallengeRepository, friendRepo repository.FriendRepository, userRepo repository.UserRepository, sessions Sessions, stats TeamStats, ttl time.Duration, l *logger.Logger) *challengeService {
+	return &challengeService{
+		challengeRepo: challengeRepo,
+		friendRepo:    friendRepo,
+		userRepo:      userRepo,
+		sessions:      sessions,
+		stats:         stats,
+		ttl:           ttl,
+		l:             l,
+	}
+}
+
+// ChallengeFriend отправляет другу вызов на квиз. Счет вызвавшего берется из его
+// завершенной сессии в quiz_service, клиенту не доверяем. С teams вызов командный:
+// друг попадает в команду соперников, все участники должны быть друзьями вызвавшего.
+func (s *challengeService) ChallengeFriend(ctx context.Context, user *entity.User, quizID string, friendID string, sessionID string, teams *entity.ChallengeTeams) (*entity.Challenge, error) {
+	friend, err := s.userRepo.FindByUsername(ctx, friendID)
+	if err != nil {
+		return nil, ErrFriendNotFound
+	}
+	if friend.ID == user.ID {
+		return nil, ErrCannotChallengeYourself
+	}
+	if !s.friendRepo.CheckFriendship(ctx, user.ID, friend.ID) {
+		return nil, ErrNotFriends
+	}
+
+	session, err := s.playedSession(ctx, user, quizID, sessionID, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	score := session.GetScore()
+	challenge := &entity.Challenge{
+		QuizID:          session.GetQuizId(),
+		ChallengerID:    user.ID,
+		OpponentID:      friend.ID,
+		ChallengerScore: score,
+		TeamScoring:     entity.ScoringAverage,
+		Members: []entity.ChallengeMember{
+			{UserID: user.ID, Username: user.Username, Score: &score},
+			{UserID: friend.ID, Username: friend.Username, Opponent: true},
+		},
+	}
+	if teams != nil {
+		if err = s.addTeams(ctx, challenge, user, teams); err != nil {
+			return nil, err
+		}
+	}
+
+	id, err := s.challengeRepo.CreateChallenge(ctx, challenge, sessionID, s.ttl)
+	if err != nil {
+		if !errors.Is(err, entity.ErrChallengeExists) {
+			s.l.Error("Failed to create challenge", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return s.challengeRepo.GetChallenge(ctx, id)
+}
+
+// addTeams проверяет составы команд и добавляет участников в вызов
+func (s *challengeService) addTeams(ctx context.Context, challenge *entity.Challenge, user *entity.User, teams *entity.ChallengeTeams) error {
+	challenge.Team = strings.TrimSpace(teams.Team)
+	challenge.OpponentTeam = strings.TrimSpace(teams.OpponentTeam)
+	for _, name := range []string{challenge.Team, challenge.OpponentTeam} {
+		if name == "" || utf8.RuneCountInString(name) > maxTeamName {
+			return fmt.Errorf("%w: team name must be from 1 to %d characters", ErrInvalidTeams, maxTeamName)
+		}
+	}
+	if challenge.Team == challenge.OpponentTeam {
+		return fmt.Errorf("%w: teams must have different names", ErrInvalidTeams)
+	}
+	if teams.Scoring == entity.ScoringSum {
+		challenge.TeamScoring = entity.ScoringSum
+	}
+	if len(teams.Teammates)+1 > maxTeamMembers || len(teams.Opponents)+1 > maxTeamMembers {
+		return fmt.Errorf("%w: a team can have at most %d members", ErrInvalidTeams, maxTeamMembers)
+	}
+
+	seen := []string{user.Username, challenge.Members[1].Username}
+	for _, side := range []struct {
+		usernames []string
+		opponent  bool
+	}{{teams.Teammates, false}, {teams.Opponents, true}} {
+		for _, username := range side.usernames {
+			// Друг из friend_id может быть повторен в списке соперников
+			if side.opponent && username == seen[1] {
+				continue
+			}
+			if slices.Contains(seen, username) {
+				return fmt.Errorf("%w: %s is listed twice", ErrInvalidTeams, username)
+			}
+			seen = append(seen, username)
+			member, err := s.userRepo.FindByUsername(ctx, username)
+			if err != nil {
+				return fmt.Errorf("%w: %s", ErrFriendNotFound, username)
+			}
+			if !s.friendRepo.CheckFriendship(ctx, user.ID, member.ID) {
+				return fmt.Errorf("%w: %s", ErrNotFriends, username)
+			}
+			challenge.Members = append(challenge.Members, entity.ChallengeMember{UserID: member.ID, Username: username, Opponent: side.opponent})
+		}
+	}
+	return nil
+}
+
+// CompleteChallenge записывает результат участника. Засчитывается только сессия,
+// начатая после отправки вызова. Итог подводится, когда сыграли все участники,
+// итоги командного вызова уходят в историю команд stat_service.
+func (s *challengeService) CompleteChallenge(ctx context.Context, user *entity.User, challengeID uint64, sessionID string) (*entity.Challenge, error) {
+	challenge, err := s.challengeRepo.GetChallenge(ctx, challengeID)
+	if err != nil {
+		return nil, err
+	}
+	member := challenge.Member(user.ID)
+	if member == nil {
+		return nil, ErrOperationNotAllowed
+	}
+	if challenge.Status != entity.ChallengePending {
+		return nil, entity.ErrChallengeNotActive
+	}
+	if member.Score != nil {
+		return nil, entity.ErrChallengePlayed
+	}
+
+	session, err := s.playedSession(ctx, user, challenge.QuizID, sessionID, challenge.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.challengeRepo.CompleteChallenge(ctx, challengeID, user.ID, sessionID, session.GetScore()); err != nil {
+		if !errors.Is(err, entity.ErrChallengeNotActive) && !errors.Is(err, entity.ErrChallengeExists) && !errors.Is(err, entity.ErrChallengePlayed) {
+			s.l.Error("Failed to complete challenge", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	challenge, err = s.challengeRepo.GetChallenge(ctx, challengeID)
+	if err != nil {
+		return nil, err
+	}
+	if challenge.IsTeam() && challenge.Status == entity.ChallengeCompleted {
+		s.recordTeams(ctx, challenge)
+	}
+	return challenge, nil
+}
+
+// recordTeams отправляет итоги командного вызова в stat_service. Ошибка только пишется
+// в лог: вызов уже завершен, а повторная отправка в stat_service ничего не задвоит.
+func (s *challengeService) recordTeams(ctx context.Context, challenge *entity.Challenge) {
+	if s.stats == nil || challenge.OpponentScore == nil {
+		return
+	}
+	var players [2]int32
+	for _, member := range challenge.Members {
+		if member.Opponent {
+			players[1]++
+		} else {
+			players[0]++
+		}
+	}
+	ranks := [2]int32{1, 1}
+	switch challenge.WinnerTeam {
+	case challenge.Team:
+		ranks[1] = 2
+	case challenge.OpponentTeam:
+		ranks[0] = 2
+	}
+	req := &statapi.RecordTeamResultsRequest{
+		Source:   "challenge",
+		SourceId: strconv.FormatUint(challenge.ID, 10),
+		QuizId:   challenge.QuizID,
+		Owner:    challenge.Challenger,
+		Teams: []*statapi.TeamResult{
+			{Team: challenge.Team, Score: challenge.ChallengerScore, Rank: ranks[0], NumPlayers: players[0]},
+			{Team: challenge.OpponentTeam, Score: *challenge.OpponentScore, Rank: ranks[1], NumPlayers: players[1]},
+		},
+	}
+	if challenge.TeamScoring == entity.ScoringSum {
+		req.Scoring = statapi.TeamScoring_TEAM_SCORING_SUM
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), teamStatsTimeout)
+	defer cancel()
+	if _, err := s.stats.RecordTeamResults(ctx, req); err != nil {
+		s.l.Error("Failed to record team results", zap.Uint64("challenge_id", challenge.ID), zap.Error(err))
+	}
+}
+
+func (s *challengeService) GetChallenges(ctx context.Context, userID uint64) ([]*entity.Challenge, error) {
+	challenges, err := s.challengeRepo.GetChallenges(ctx, userID)
+	if err != nil {
+		s.l.Error("Failed to get challenges", zap.Error(err))
+		return nil, errors.New("failed to get challenges")
+	}
+
+	return challenges, nil
+}
+
+// playedSession проверяет, что сессию завершил сам пользователь на нужном квизе
+// и начал ее не раньше since. Пустой quizID принимает любой квиз.
+func (s *challengeService) playedSession(ctx context.Context, user *entity.User, quizID string, sessionID string, since time.Time) (*quizapi.GetSessionResultResponse, error) {
+	// quiz_service отдает результат сессии только ее игроку, поэтому передаем его токен
+	md, _ := metadata.FromIncomingContext(ctx)
+	outgoing := metadata.NewOutgoingContext(ctx, metadata.MD{"authorization": md.Get("authorization")})
+	session, err := s.sessions.GetSessionResult(outgoing, &quizapi.GetSessionResultRequest{SessionId: sessionID})
+	if status.Code(err) == codes.NotFound {
+		return nil, fmt.Errorf("%w: session not found", ErrInvalidSession)
+	}
+	if err != nil {
+		s.l.Error("Failed to get session result", zap.Error(err))
+		return nil, fmt.Errorf("failed to get session result: %w", err)
+	}
+
+	switch {
+	case session.GetPlayer() != user.Username:
+		return nil, fmt.Errorf("%w: session belongs to another player", ErrInvalidSession)
+	case quizID != "" && session.GetQuizId() != quizID:
+		return nil, fmt.Errorf("%w: session is for another quiz", ErrInvalidSession)
+	case !session.GetFinished():
+		return nil, fmt.Errorf("%w: session is not finished", ErrInvalidSession)
+	}
+	if !since.IsZero() {
+		startedAt, err := time.Parse(time.RFC3339Nano, session.GetStartedAt())
+		if err != nil || startedAt.Before(since) {
+			return nil, fmt.Errorf("%w: session was started before the challenge", ErrInvalidSession)
+		}
+	}
+
+	return session, nil
+}
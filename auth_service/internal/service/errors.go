@@ -10,4 +10,8 @@ var (
 	ErrNotFriends          = errors.New("users are not friends")
 	ErrFriendNotFound      = errors.New("friend not found")
 	ErrOperationNotAllowed = errors.New("operation not allowed")
+
+	ErrCannotChallengeYourself = errors.New("cannot challenge yourself")
+	ErrInvalidSession          = errors.New("invalid session")
+	ErrInvalidTeams            = errors.New("invalid teams")
 )
@@ -17,6 +17,7 @@ type FriendService interface {
 	RemoveFriend(ctx context.Context, userID uint64, friendID string) error
 
 	GetFriends(ctx context.Context, userID uint64) ([]*entity.User, error)
+	GetFriendOf(ctx context.Context, userID uint64) ([]string, error)
 
 	CheckFriendship(ctx context.Context, userID uint64, friendID string) (bool, error)
 }
@@ -103,6 +104,16 @@ func (s *friendService) GetFriends(ctx context.Context, userID uint64) ([]*entit
 	return friends, nil
 }
 
+func (s *friendService) GetFriendOf(ctx context.Context, userID uint64) ([]string, error) {
+	usernames, err := s.friendRepo.GetFriendOfUsernames(ctx, userID)
+	if err != nil {
+		s.l.Error("Failed to get friend of list", zap.Error(err))
+		return nil, errors.New("failed to get friend of list")
+	}
+
+	return usernames, nil
+}
+
 func (s *friendService) CheckFriendship(ctx context.Context, userID uint64, friendID string) (bool, error) {
 	userFriend, err := s.userRepo.FindByUsername(ctx, friendID)
 	if err != nil {
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS challenges;
\ No newline at end of file
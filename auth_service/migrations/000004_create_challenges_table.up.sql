@@ -0,0 +1,21 @@
+CREATE TABLE challenges (
+    id SERIAL PRIMARY KEY,
+    quiz_id VARCHAR(255) NOT NULL,
+    challenger_id BIGINT NOT NULL,
+    opponent_id BIGINT NOT NULL,
+    challenger_session VARCHAR(255) NOT NULL,
+    challenger_score REAL NOT NULL,
+    opponent_session VARCHAR(255) UNIQUE,
+    opponent_score REAL,
+    winner_id BIGINT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    completed_at TIMESTAMPTZ,
+    UNIQUE (challenger_session, opponent_id),
+    FOREIGN KEY (challenger_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (opponent_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (winner_id) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_challenges_challenger_id ON challenges(challenger_id);
+CREATE INDEX idx_challenges_opponent_id ON challenges(opponent_id);
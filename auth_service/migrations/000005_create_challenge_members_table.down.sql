@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS challenge_members;
+ALTER TABLE challenges DROP COLUMN IF EXISTS winner_team;
+ALTER TABLE challenges DROP COLUMN IF EXISTS team_scoring;
+ALTER TABLE challenges DROP COLUMN IF EXISTS opponent_team;
+ALTER TABLE challenges DROP COLUMN IF EXISTS team;
@@ -0,0 +1,26 @@
+ALTER TABLE challenges ADD COLUMN team VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE challenges ADD COLUMN opponent_team VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE challenges ADD COLUMN team_scoring VARCHAR(16) NOT NULL DEFAULT 'average';
+ALTER TABLE challenges ADD COLUMN winner_team VARCHAR(255) NOT NULL DEFAULT '';
+
+CREATE TABLE challenge_members (
+    challenge_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    opponent BOOLEAN NOT NULL,
+    sender BOOLEAN NOT NULL DEFAULT FALSE,
+    session_id VARCHAR(255),
+    score REAL,
+    played_at TIMESTAMPTZ,
+    PRIMARY KEY (challenge_id, user_id),
+    FOREIGN KEY (challenge_id) REFERENCES challenges(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_challenge_members_user_id ON challenge_members(user_id);
+-- Сессия вызвавшего может стоять в нескольких вызовах, сессия ответа - только в одном
+CREATE UNIQUE INDEX idx_challenge_members_session ON challenge_members(session_id) WHERE NOT sender;
+
+INSERT INTO challenge_members (challenge_id, user_id, opponent, sender, session_id, score, played_at)
+SELECT id, challenger_id, FALSE, TRUE, challenger_session, challenger_score, created_at FROM challenges
+UNION ALL
+SELECT id, opponent_id, TRUE, FALSE, opponent_session, opponent_score, completed_at FROM challenges;
@@ -67,6 +67,50 @@ func New(ctx context.Context, username string, password string, host string, por
 		return nil, fmt.Errorf("failed to migrate database schema: %w", err)
 	}
 
+	_, err = db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS challenges (
+			id SERIAL PRIMARY KEY,
+			quiz_id VARCHAR(255) NOT NULL,
+			challenger_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+			opponent_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+			challenger_session VARCHAR(255) NOT NULL,
+			challenger_score REAL NOT NULL,
+			opponent_session VARCHAR(255) UNIQUE,
+			opponent_score REAL,
+			winner_id INTEGER REFERENCES users (id) ON DELETE SET NULL,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			expires_at TIMESTAMPTZ NOT NULL,
+			completed_at TIMESTAMPTZ,
+			UNIQUE (challenger_session, opponent_id)
+		);
+		CREATE INDEX IF NOT EXISTS idx_challenges_challenger_id ON challenges (challenger_id);
+		CREATE INDEX IF NOT EXISTS idx_challenges_opponent_id ON challenges (opponent_id);
+		ALTER TABLE challenges ADD COLUMN IF NOT EXISTS team VARCHAR(255) NOT NULL DEFAULT '';
+		ALTER TABLE challenges ADD COLUMN IF NOT EXISTS opponent_team VARCHAR(255) NOT NULL DEFAULT '';
+		ALTER TABLE challenges ADD COLUMN IF NOT EXISTS team_scoring VARCHAR(16) NOT NULL DEFAULT 'average';
+		ALTER TABLE challenges ADD COLUMN IF NOT EXISTS winner_team VARCHAR(255) NOT NULL DEFAULT '';
+		CREATE TABLE IF NOT EXISTS challenge_members (
+			challenge_id INTEGER NOT NULL REFERENCES challenges (id) ON DELETE CASCADE,
+			user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+			opponent BOOLEAN NOT NULL,
+			sender BOOLEAN NOT NULL DEFAULT FALSE,
+			session_id VARCHAR(255),
+			score REAL,
+			played_at TIMESTAMPTZ,
+			PRIMARY KEY (challenge_id, user_id)
+		);
+		CREATE INDEX IF NOT EXISTS idx_challenge_members_user_id ON challenge_members (user_id);
+		CREATE UNIQUE INDEX IF NOT EXISTS idx_challenge_members_session ON challenge_members (session_id) WHERE NOT sender;
+		INSERT INTO challenge_members (challenge_id, user_id, opponent, sender, session_id, score, played_at)
+		SELECT id, challenger_id, FALSE, TRUE, challenger_session, challenger_score, created_at FROM challenges
+		UNION ALL
+		SELECT id, opponent_id, TRUE, FALSE, opponent_session, opponent_score, completed_at FROM challenges
+		ON CONFLICT DO NOTHING;
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate database schema: %w", err)
+	}
+
 	return &DB{DB: db}, nil
 }
 func (db *DB) Close(ctx context.Context) (err error) {
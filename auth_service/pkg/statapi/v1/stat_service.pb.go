@@ -0,0 +1,3699 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: api/proto/statistics/stat_service.proto
+
+package v1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Period of ListQuizzes, ListPlayers and ListAuthors. Periods are calendar days, weeks
+// (from Monday) and months in UTC and are counted from the session history and ratings.
+type LeaderboardPeriod int32
+
+const (
+	LeaderboardPeriod_PERIOD_ALL_TIME LeaderboardPeriod = 0
+	LeaderboardPeriod_PERIOD_DAILY    LeaderboardPeriod = 1
+	LeaderboardPeriod_PERIOD_WEEKLY   LeaderboardPeriod = 2
+	LeaderboardPeriod_PERIOD_MONTHLY  LeaderboardPeriod = 3
+)
+
+// Enum value maps for LeaderboardPeriod.
+var (
+	LeaderboardPeriod_name = map[int32]string{
+		0: "PERIOD_ALL_TIME",
+		1: "PERIOD_DAILY",
+		2: "PERIOD_WEEKLY",
+		3: "PERIOD_MONTHLY",
+	}
+	LeaderboardPeriod_value = map[string]int32{
+		"PERIOD_ALL_TIME": 0,
+		"PERIOD_DAILY":    1,
+		"PERIOD_WEEKLY":   2,
+		"PERIOD_MONTHLY":  3,
+	}
+)
+
+func (x LeaderboardPeriod) Enum() *LeaderboardPeriod {
+	p := new(LeaderboardPeriod)
+	*p = x
+	return p
+}
+
+func (x LeaderboardPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaderboardPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_statistics_stat_service_proto_enumTypes[0].Descriptor()
+}
+
+func (LeaderboardPeriod) Type() protoreflect.EnumType {
+	return &file_api_proto_statistics_stat_service_proto_enumTypes[0]
+}
+
+func (x LeaderboardPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaderboardPeriod.Descriptor instead.
+func (LeaderboardPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{0}
+}
+
+type SortDirection int32
+
+const (
+	// Best first.
+	SortDirection_DIRECTION_DESC SortDirection = 0
+	SortDirection_DIRECTION_ASC  SortDirection = 1
+)
+
+// Enum value maps for SortDirection.
+var (
+	SortDirection_name = map[int32]string{
+		0: "DIRECTION_DESC",
+		1: "DIRECTION_ASC",
+	}
+	SortDirection_value = map[string]int32{
+		"DIRECTION_DESC": 0,
+		"DIRECTION_ASC":  1,
+	}
+)
+
+func (x SortDirection) Enum() *SortDirection {
+	p := new(SortDirection)
+	*p = x
+	return p
+}
+
+func (x SortDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_statistics_stat_service_proto_enumTypes[1].Descriptor()
+}
+
+func (SortDirection) Type() protoreflect.EnumType {
+	return &file_api_proto_statistics_stat_service_proto_enumTypes[1]
+}
+
+func (x SortDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortDirection.Descriptor instead.
+func (SortDirection) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{1}
+}
+
+type ListQuizzesOption int32
+
+const (
+	ListQuizzesOption_AVG_RATE     ListQuizzesOption = 0
+	ListQuizzesOption_NUM_SESSIONS ListQuizzesOption = 1
+)
+
+// Enum value maps for ListQuizzesOption.
+var (
+	ListQuizzesOption_name = map[int32]string{
+		0: "AVG_RATE",
+		1: "NUM_SESSIONS",
+	}
+	ListQuizzesOption_value = map[string]int32{
+		"AVG_RATE":     0,
+		"NUM_SESSIONS": 1,
+	}
+)
+
+func (x ListQuizzesOption) Enum() *ListQuizzesOption {
+	p := new(ListQuizzesOption)
+	*p = x
+	return p
+}
+
+func (x ListQuizzesOption) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListQuizzesOption) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_statistics_stat_service_proto_enumTypes[2].Descriptor()
+}
+
+func (ListQuizzesOption) Type() protoreflect.EnumType {
+	return &file_api_proto_statistics_stat_service_proto_enumTypes[2]
+}
+
+func (x ListQuizzesOption) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListQuizzesOption.Descriptor instead.
+func (ListQuizzesOption) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{2}
+}
+
+type ListPlayersOption int32
+
+const (
+	ListPlayersOption_TOTAL_SCORE ListPlayersOption = 0
+	ListPlayersOption_BEST_SCORE  ListPlayersOption = 1
+	ListPlayersOption_AVG_SCORE   ListPlayersOption = 2
+)
+
+// Enum value maps for ListPlayersOption.
+var (
+	ListPlayersOption_name = map[int32]string{
+		0: "TOTAL_SCORE",
+		1: "BEST_SCORE",
+		2: "AVG_SCORE",
+	}
+	ListPlayersOption_value = map[string]int32{
+		"TOTAL_SCORE": 0,
+		"BEST_SCORE":  1,
+		"AVG_SCORE":   2,
+	}
+)
+
+func (x ListPlayersOption) Enum() *ListPlayersOption {
+	p := new(ListPlayersOption)
+	*p = x
+	return p
+}
+
+func (x ListPlayersOption) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListPlayersOption) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_statistics_stat_service_proto_enumTypes[3].Descriptor()
+}
+
+func (ListPlayersOption) Type() protoreflect.EnumType {
+	return &file_api_proto_statistics_stat_service_proto_enumTypes[3]
+}
+
+func (x ListPlayersOption) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListPlayersOption.Descriptor instead.
+func (ListPlayersOption) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{3}
+}
+
+type ListAuthorsOption int32
+
+const (
+	ListAuthorsOption_NUM_QUIZZES    ListAuthorsOption = 0
+	ListAuthorsOption_AVG_QUIZ_RATE  ListAuthorsOption = 1
+	ListAuthorsOption_BEST_QUIZ_RATE ListAuthorsOption = 2
+)
+
+// Enum value maps for ListAuthorsOption.
+var (
+	ListAuthorsOption_name = map[int32]string{
+		0: "NUM_QUIZZES",
+		1: "AVG_QUIZ_RATE",
+		2: "BEST_QUIZ_RATE",
+	}
+	ListAuthorsOption_value = map[string]int32{
+		"NUM_QUIZZES":    0,
+		"AVG_QUIZ_RATE":  1,
+		"BEST_QUIZ_RATE": 2,
+	}
+)
+
+func (x ListAuthorsOption) Enum() *ListAuthorsOption {
+	p := new(ListAuthorsOption)
+	*p = x
+	return p
+}
+
+func (x ListAuthorsOption) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListAuthorsOption) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_statistics_stat_service_proto_enumTypes[4].Descriptor()
+}
+
+func (ListAuthorsOption) Type() protoreflect.EnumType {
+	return &file_api_proto_statistics_stat_service_proto_enumTypes[4]
+}
+
+func (x ListAuthorsOption) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListAuthorsOption.Descriptor instead.
+func (ListAuthorsOption) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{4}
+}
+
+type TeamScoring int32
+
+const (
+	TeamScoring_TEAM_SCORING_AVERAGE TeamScoring = 0
+	TeamScoring_TEAM_SCORING_SUM     TeamScoring = 1
+)
+
+// Enum value maps for TeamScoring.
+var (
+	TeamScoring_name = map[int32]string{
+		0: "TEAM_SCORING_AVERAGE",
+		1: "TEAM_SCORING_SUM",
+	}
+	TeamScoring_value = map[string]int32{
+		"TEAM_SCORING_AVERAGE": 0,
+		"TEAM_SCORING_SUM":     1,
+	}
+)
+
+func (x TeamScoring) Enum() *TeamScoring {
+	p := new(TeamScoring)
+	*p = x
+	return p
+}
+
+func (x TeamScoring) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TeamScoring) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_statistics_stat_service_proto_enumTypes[5].Descriptor()
+}
+
+func (TeamScoring) Type() protoreflect.EnumType {
+	return &file_api_proto_statistics_stat_service_proto_enumTypes[5]
+}
+
+func (x TeamScoring) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TeamScoring.Descriptor instead.
+func (TeamScoring) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{5}
+}
+
+type ListTeamsOption int32
+
+const (
+	ListTeamsOption_TEAM_WINS         ListTeamsOption = 0
+	ListTeamsOption_TEAM_AVG_RANK     ListTeamsOption = 1
+	ListTeamsOption_TEAM_NUM_SESSIONS ListTeamsOption = 2
+)
+
+// Enum value maps for ListTeamsOption.
+var (
+	ListTeamsOption_name = map[int32]string{
+		0: "TEAM_WINS",
+		1: "TEAM_AVG_RANK",
+		2: "TEAM_NUM_SESSIONS",
+	}
+	ListTeamsOption_value = map[string]int32{
+		"TEAM_WINS":         0,
+		"TEAM_AVG_RANK":     1,
+		"TEAM_NUM_SESSIONS": 2,
+	}
+)
+
+func (x ListTeamsOption) Enum() *ListTeamsOption {
+	p := new(ListTeamsOption)
+	*p = x
+	return p
+}
+
+func (x ListTeamsOption) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListTeamsOption) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_statistics_stat_service_proto_enumTypes[6].Descriptor()
+}
+
+func (ListTeamsOption) Type() protoreflect.EnumType {
+	return &file_api_proto_statistics_stat_service_proto_enumTypes[6]
+}
+
+func (x ListTeamsOption) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListTeamsOption.Descriptor instead.
+func (ListTeamsOption) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{6}
+}
+
+// Sessions
+type UpdateStatsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	QuizId      string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	AuthorId    string                 `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	PlayerId    string                 `protobuf:"bytes,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PlayerScore float32                `protobuf:"fixed32,4,opt,name=player_score,json=playerScore,proto3" json:"player_score,omitempty"`
+	// Ignored: finishing a session no longer rates the quiz, ratings are set with RateQuiz.
+	//
+	// Deprecated: Marked as deprecated in api/proto/statistics/stat_service.proto.
+	QuizRate float32 `protobuf:"fixed32,5,opt,name=quiz_rate,json=quizRate,proto3" json:"quiz_rate,omitempty"`
+	// Finished play session in quiz_service; the score is taken from it, not from the client.
+	SessionId string `protobuf:"bytes,6,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Defaults to session_id. A session is counted once: a repeated key or session changes nothing
+	// and returns the result recorded the first time.
+	IdempotencyKey string `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateStatsRequest) Reset() {
+	*x = UpdateStatsRequest{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStatsRequest) ProtoMessage() {}
+
+func (x *UpdateStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStatsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpdateStatsRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *UpdateStatsRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *UpdateStatsRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *UpdateStatsRequest) GetPlayerScore() float32 {
+	if x != nil {
+		return x.PlayerScore
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in api/proto/statistics/stat_service.proto.
+func (x *UpdateStatsRequest) GetQuizRate() float32 {
+	if x != nil {
+		return x.QuizRate
+	}
+	return 0
+}
+
+func (x *UpdateStatsRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *UpdateStatsRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type UpdateStatsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Session *PlayedSession         `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	// True if the session had already been counted.
+	Replayed      bool `protobuf:"varint,2,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateStatsResponse) Reset() {
+	*x = UpdateStatsResponse{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStatsResponse) ProtoMessage() {}
+
+func (x *UpdateStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStatsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateStatsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateStatsResponse) GetSession() *PlayedSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *UpdateStatsResponse) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
+// One played session as recorded by UpdateStats.
+type PlayedSession struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	QuizId    string                 `protobuf:"bytes,2,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	AuthorId  string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	PlayerId  string                 `protobuf:"bytes,4,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Score     float32                `protobuf:"fixed32,5,opt,name=score,proto3" json:"score,omitempty"`
+	// Rating sent with the session by older clients, 0 for sessions counted since ratings moved to RateQuiz.
+	QuizRate float32 `protobuf:"fixed32,6,opt,name=quiz_rate,json=quizRate,proto3" json:"quiz_rate,omitempty"`
+	// From the start to the end of the session in quiz_service.
+	DurationMs int64 `protobuf:"varint,7,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	// RFC 3339.
+	PlayedAt      string `protobuf:"bytes,8,opt,name=played_at,json=playedAt,proto3" json:"played_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayedSession) Reset() {
+	*x = PlayedSession{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayedSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayedSession) ProtoMessage() {}
+
+func (x *PlayedSession) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayedSession.ProtoReflect.Descriptor instead.
+func (*PlayedSession) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PlayedSession) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PlayedSession) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *PlayedSession) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PlayedSession) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *PlayedSession) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *PlayedSession) GetQuizRate() float32 {
+	if x != nil {
+		return x.QuizRate
+	}
+	return 0
+}
+
+func (x *PlayedSession) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *PlayedSession) GetPlayedAt() string {
+	if x != nil {
+		return x.PlayedAt
+	}
+	return ""
+}
+
+type ListPlayerSessionsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Defaults to 20, at most 100.
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlayerSessionsRequest) Reset() {
+	*x = ListPlayerSessionsRequest{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlayerSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlayerSessionsRequest) ProtoMessage() {}
+
+func (x *ListPlayerSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlayerSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPlayerSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListPlayerSessionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListPlayerSessionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPlayerSessionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListQuizSessionsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	QuizId string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	// Defaults to 20, at most 100.
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuizSessionsRequest) Reset() {
+	*x = ListQuizSessionsRequest{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuizSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuizSessionsRequest) ProtoMessage() {}
+
+func (x *ListQuizSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuizSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListQuizSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListQuizSessionsRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *ListQuizSessionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListQuizSessionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListSessionsResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Sessions []*PlayedSession       `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	// Empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*PlayedSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *ListSessionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type QuizStat struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	QuizId      string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	AuthorId    string                 `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	NumSessions int32                  `protobuf:"varint,3,opt,name=num_sessions,json=numSessions,proto3" json:"num_sessions,omitempty"`
+	AvgRate     float32                `protobuf:"fixed32,4,opt,name=avg_rate,json=avgRate,proto3" json:"avg_rate,omitempty"`
+	NumRatings  int32                  `protobuf:"varint,5,opt,name=num_ratings,json=numRatings,proto3" json:"num_ratings,omitempty"`
+	// Place in the leaderboard, set only by ListQuizzes.
+	Rank          int32 `protobuf:"varint,6,opt,name=rank,proto3" json:"rank,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizStat) Reset() {
+	*x = QuizStat{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizStat) ProtoMessage() {}
+
+func (x *QuizStat) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizStat.ProtoReflect.Descriptor instead.
+func (*QuizStat) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QuizStat) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *QuizStat) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *QuizStat) GetNumSessions() int32 {
+	if x != nil {
+		return x.NumSessions
+	}
+	return 0
+}
+
+func (x *QuizStat) GetAvgRate() float32 {
+	if x != nil {
+		return x.AvgRate
+	}
+	return 0
+}
+
+func (x *QuizStat) GetNumRatings() int32 {
+	if x != nil {
+		return x.NumRatings
+	}
+	return 0
+}
+
+func (x *QuizStat) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+type GetQuizStatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuizStatRequest) Reset() {
+	*x = GetQuizStatRequest{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuizStatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuizStatRequest) ProtoMessage() {}
+
+func (x *GetQuizStatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuizStatRequest.ProtoReflect.Descriptor instead.
+func (*GetQuizStatRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetQuizStatRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+type GetQuizStatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quiz          *QuizStat              `protobuf:"bytes,1,opt,name=quiz,proto3" json:"quiz,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuizStatResponse) Reset() {
+	*x = GetQuizStatResponse{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuizStatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuizStatResponse) ProtoMessage() {}
+
+func (x *GetQuizStatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuizStatResponse.ProtoReflect.Descriptor instead.
+func (*GetQuizStatResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetQuizStatResponse) GetQuiz() *QuizStat {
+	if x != nil {
+		return x.Quiz
+	}
+	return nil
+}
+
+type ListQuizzesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Option    ListQuizzesOption      `protobuf:"varint,1,opt,name=option,proto3,enum=api.ListQuizzesOption" json:"option,omitempty"`
+	Period    LeaderboardPeriod      `protobuf:"varint,2,opt,name=period,proto3,enum=api.LeaderboardPeriod" json:"period,omitempty"`
+	Direction SortDirection          `protobuf:"varint,3,opt,name=direction,proto3,enum=api.SortDirection" json:"direction,omitempty"`
+	// Defaults to 20, at most 100.
+	PageSize      int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuizzesRequest) Reset() {
+	*x = ListQuizzesRequest{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuizzesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuizzesRequest) ProtoMessage() {}
+
+func (x *ListQuizzesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuizzesRequest.ProtoReflect.Descriptor instead.
+func (*ListQuizzesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListQuizzesRequest) GetOption() ListQuizzesOption {
+	if x != nil {
+		return x.Option
+	}
+	return ListQuizzesOption_AVG_RATE
+}
+
+func (x *ListQuizzesRequest) GetPeriod() LeaderboardPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return LeaderboardPeriod_PERIOD_ALL_TIME
+}
+
+func (x *ListQuizzesRequest) GetDirection() SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return SortDirection_DIRECTION_DESC
+}
+
+func (x *ListQuizzesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListQuizzesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListQuizzesResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Quizzes []*QuizStat            `protobuf:"bytes,1,rep,name=quizzes,proto3" json:"quizzes,omitempty"`
+	// Empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Quizzes of the caller with their ranks, wherever they are in the leaderboard.
+	// Empty without a bearer token.
+	Mine          []*QuizStat `protobuf:"bytes,3,rep,name=mine,proto3" json:"mine,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuizzesResponse) Reset() {
+	*x = ListQuizzesResponse{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuizzesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuizzesResponse) ProtoMessage() {}
+
+func (x *ListQuizzesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuizzesResponse.ProtoReflect.Descriptor instead.
+func (*ListQuizzesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListQuizzesResponse) GetQuizzes() []*QuizStat {
+	if x != nil {
+		return x.Quizzes
+	}
+	return nil
+}
+
+func (x *ListQuizzesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListQuizzesResponse) GetMine() []*QuizStat {
+	if x != nil {
+		return x.Mine
+	}
+	return nil
+}
+
+type QuizRating struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	QuizId string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Rate   float32                `protobuf:"fixed32,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	Review string                 `protobuf:"bytes,4,opt,name=review,proto3" json:"review,omitempty"`
+	// RFC 3339, the last time the rating was changed.
+	UpdatedAt     string `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizRating) Reset() {
+	*x = QuizRating{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizRating) ProtoMessage() {}
+
+func (x *QuizRating) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizRating.ProtoReflect.Descriptor instead.
+func (*QuizRating) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QuizRating) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *QuizRating) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *QuizRating) GetRate() float32 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *QuizRating) GetReview() string {
+	if x != nil {
+		return x.Review
+	}
+	return ""
+}
+
+func (x *QuizRating) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type RateQuizRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	QuizId string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	// From 1.0 to 5.0.
+	Rate float32 `protobuf:"fixed32,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	// Optional, up to 2000 characters. Rating again without a review removes the old one.
+	Review        string `protobuf:"bytes,3,opt,name=review,proto3" json:"review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateQuizRequest) Reset() {
+	*x = RateQuizRequest{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateQuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateQuizRequest) ProtoMessage() {}
+
+func (x *RateQuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateQuizRequest.ProtoReflect.Descriptor instead.
+func (*RateQuizRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RateQuizRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *RateQuizRequest) GetRate() float32 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *RateQuizRequest) GetReview() string {
+	if x != nil {
+		return x.Review
+	}
+	return ""
+}
+
+type RateQuizResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rating        *QuizRating            `protobuf:"bytes,1,opt,name=rating,proto3" json:"rating,omitempty"`
+	Quiz          *QuizStat              `protobuf:"bytes,2,opt,name=quiz,proto3" json:"quiz,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateQuizResponse) Reset() {
+	*x = RateQuizResponse{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateQuizResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateQuizResponse) ProtoMessage() {}
+
+func (x *RateQuizResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateQuizResponse.ProtoReflect.Descriptor instead.
+func (*RateQuizResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RateQuizResponse) GetRating() *QuizRating {
+	if x != nil {
+		return x.Rating
+	}
+	return nil
+}
+
+func (x *RateQuizResponse) GetQuiz() *QuizStat {
+	if x != nil {
+		return x.Quiz
+	}
+	return nil
+}
+
+type ListQuizRatingsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	QuizId string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	// Defaults to 20, at most 100.
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuizRatingsRequest) Reset() {
+	*x = ListQuizRatingsRequest{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuizRatingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuizRatingsRequest) ProtoMessage() {}
+
+func (x *ListQuizRatingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuizRatingsRequest.ProtoReflect.Descriptor instead.
+func (*ListQuizRatingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListQuizRatingsRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *ListQuizRatingsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListQuizRatingsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListQuizRatingsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Ratings []*QuizRating          `protobuf:"bytes,1,rep,name=ratings,proto3" json:"ratings,omitempty"`
+	// Empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuizRatingsResponse) Reset() {
+	*x = ListQuizRatingsResponse{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuizRatingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuizRatingsResponse) ProtoMessage() {}
+
+func (x *ListQuizRatingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuizRatingsResponse.ProtoReflect.Descriptor instead.
+func (*ListQuizRatingsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListQuizRatingsResponse) GetRatings() []*QuizRating {
+	if x != nil {
+		return x.Ratings
+	}
+	return nil
+}
+
+func (x *ListQuizRatingsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type DistractorStat struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AnswerId  string                 `protobuf:"bytes,1,opt,name=answer_id,json=answerId,proto3" json:"answer_id,omitempty"`
+	NumPicked int32                  `protobuf:"varint,2,opt,name=num_picked,json=numPicked,proto3" json:"num_picked,omitempty"`
+	// Share of the answers to the question that picked it, from 0 to 100.
+	PercentPicked float32 `protobuf:"fixed32,3,opt,name=percent_picked,json=percentPicked,proto3" json:"percent_picked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DistractorStat) Reset() {
+	*x = DistractorStat{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DistractorStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DistractorStat) ProtoMessage() {}
+
+func (x *DistractorStat) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DistractorStat.ProtoReflect.Descriptor instead.
+func (*DistractorStat) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DistractorStat) GetAnswerId() string {
+	if x != nil {
+		return x.AnswerId
+	}
+	return ""
+}
+
+func (x *DistractorStat) GetNumPicked() int32 {
+	if x != nil {
+		return x.NumPicked
+	}
+	return 0
+}
+
+func (x *DistractorStat) GetPercentPicked() float32 {
+	if x != nil {
+		return x.PercentPicked
+	}
+	return 0
+}
+
+type QuestionStat struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId string                 `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	NumAnswers int32                  `protobuf:"varint,2,opt,name=num_answers,json=numAnswers,proto3" json:"num_answers,omitempty"`
+	// From 0 to 100.
+	PercentCorrect float32 `protobuf:"fixed32,3,opt,name=percent_correct,json=percentCorrect,proto3" json:"percent_correct,omitempty"`
+	AvgTimeMs      int64   `protobuf:"varint,4,opt,name=avg_time_ms,json=avgTimeMs,proto3" json:"avg_time_ms,omitempty"`
+	// Wrong options of choice questions, the most picked first.
+	Distractors []*DistractorStat `protobuf:"bytes,5,rep,name=distractors,proto3" json:"distractors,omitempty"`
+	// Share correct among the best 27% of sessions minus among the worst 27%, from -1 to 1.
+	// Low or negative values point to an ambiguous question. 0 with fewer than 2 answers.
+	DiscriminationIndex float32 `protobuf:"fixed32,6,opt,name=discrimination_index,json=discriminationIndex,proto3" json:"discrimination_index,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *QuestionStat) Reset() {
+	*x = QuestionStat{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionStat) ProtoMessage() {}
+
+func (x *QuestionStat) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionStat.ProtoReflect.Descriptor instead.
+func (*QuestionStat) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *QuestionStat) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *QuestionStat) GetNumAnswers() int32 {
+	if x != nil {
+		return x.NumAnswers
+	}
+	return 0
+}
+
+func (x *QuestionStat) GetPercentCorrect() float32 {
+	if x != nil {
+		return x.PercentCorrect
+	}
+	return 0
+}
+
+func (x *QuestionStat) GetAvgTimeMs() int64 {
+	if x != nil {
+		return x.AvgTimeMs
+	}
+	return 0
+}
+
+func (x *QuestionStat) GetDistractors() []*DistractorStat {
+	if x != nil {
+		return x.Distractors
+	}
+	return nil
+}
+
+func (x *QuestionStat) GetDiscriminationIndex() float32 {
+	if x != nil {
+		return x.DiscriminationIndex
+	}
+	return 0
+}
+
+type GetQuestionStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuestionStatsRequest) Reset() {
+	*x = GetQuestionStatsRequest{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuestionStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuestionStatsRequest) ProtoMessage() {}
+
+func (x *GetQuestionStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuestionStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetQuestionStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetQuestionStatsRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+type GetQuestionStatsResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	QuizId string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	// Sessions with recorded answers.
+	NumSessions   int32           `protobuf:"varint,2,opt,name=num_sessions,json=numSessions,proto3" json:"num_sessions,omitempty"`
+	Questions     []*QuestionStat `protobuf:"bytes,3,rep,name=questions,proto3" json:"questions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuestionStatsResponse) Reset() {
+	*x = GetQuestionStatsResponse{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuestionStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuestionStatsResponse) ProtoMessage() {}
+
+func (x *GetQuestionStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuestionStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetQuestionStatsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetQuestionStatsResponse) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *GetQuestionStatsResponse) GetNumSessions() int32 {
+	if x != nil {
+		return x.NumSessions
+	}
+	return 0
+}
+
+func (x *GetQuestionStatsResponse) GetQuestions() []*QuestionStat {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+type PlayerStat struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UserId      string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TotalScore  float32                `protobuf:"fixed32,2,opt,name=total_score,json=totalScore,proto3" json:"total_score,omitempty"`
+	BestScore   float32                `protobuf:"fixed32,3,opt,name=best_score,json=bestScore,proto3" json:"best_score,omitempty"`
+	AvgScore    float32                `protobuf:"fixed32,4,opt,name=avg_score,json=avgScore,proto3" json:"avg_score,omitempty"`
+	NumSessions int32                  `protobuf:"varint,5,opt,name=num_sessions,json=numSessions,proto3" json:"num_sessions,omitempty"`
+	// Place in the leaderboard, set only by ListPlayers.
+	Rank          int32 `protobuf:"varint,6,opt,name=rank,proto3" json:"rank,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerStat) Reset() {
+	*x = PlayerStat{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerStat) ProtoMessage() {}
+
+func (x *PlayerStat) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerStat.ProtoReflect.Descriptor instead.
+func (*PlayerStat) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PlayerStat) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PlayerStat) GetTotalScore() float32 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+func (x *PlayerStat) GetBestScore() float32 {
+	if x != nil {
+		return x.BestScore
+	}
+	return 0
+}
+
+func (x *PlayerStat) GetAvgScore() float32 {
+	if x != nil {
+		return x.AvgScore
+	}
+	return 0
+}
+
+func (x *PlayerStat) GetNumSessions() int32 {
+	if x != nil {
+		return x.NumSessions
+	}
+	return 0
+}
+
+func (x *PlayerStat) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+type GetPlayerStatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlayerStatRequest) Reset() {
+	*x = GetPlayerStatRequest{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerStatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerStatRequest) ProtoMessage() {}
+
+func (x *GetPlayerStatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerStatRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerStatRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetPlayerStatRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetPlayerStatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Player        *PlayerStat            `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlayerStatResponse) Reset() {
+	*x = GetPlayerStatResponse{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerStatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerStatResponse) ProtoMessage() {}
+
+func (x *GetPlayerStatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerStatResponse.ProtoReflect.Descriptor instead.
+func (*GetPlayerStatResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetPlayerStatResponse) GetPlayer() *PlayerStat {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+type ListPlayersRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Option    ListPlayersOption      `protobuf:"varint,1,opt,name=option,proto3,enum=api.ListPlayersOption" json:"option,omitempty"`
+	Period    LeaderboardPeriod      `protobuf:"varint,2,opt,name=period,proto3,enum=api.LeaderboardPeriod" json:"period,omitempty"`
+	Direction SortDirection          `protobuf:"varint,3,opt,name=direction,proto3,enum=api.SortDirection" json:"direction,omitempty"`
+	// Defaults to 20, at most 100.
+	PageSize      int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlayersRequest) Reset() {
+	*x = ListPlayersRequest{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlayersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlayersRequest) ProtoMessage() {}
+
+func (x *ListPlayersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlayersRequest.ProtoReflect.Descriptor instead.
+func (*ListPlayersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListPlayersRequest) GetOption() ListPlayersOption {
+	if x != nil {
+		return x.Option
+	}
+	return ListPlayersOption_TOTAL_SCORE
+}
+
+func (x *ListPlayersRequest) GetPeriod() LeaderboardPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return LeaderboardPeriod_PERIOD_ALL_TIME
+}
+
+func (x *ListPlayersRequest) GetDirection() SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return SortDirection_DIRECTION_DESC
+}
+
+func (x *ListPlayersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPlayersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListPlayersResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Players []*PlayerStat          `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	// Empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The caller with their rank, wherever they are in the leaderboard.
+	// Missing without a bearer token or if the caller did not play in the period.
+	Me            *PlayerStat `protobuf:"bytes,3,opt,name=me,proto3" json:"me,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlayersResponse) Reset() {
+	*x = ListPlayersResponse{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlayersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlayersResponse) ProtoMessage() {}
+
+func (x *ListPlayersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlayersResponse.ProtoReflect.Descriptor instead.
+func (*ListPlayersResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListPlayersResponse) GetPlayers() []*PlayerStat {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *ListPlayersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListPlayersResponse) GetMe() *PlayerStat {
+	if x != nil {
+		return x.Me
+	}
+	return nil
+}
+
+type AuthorStat struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	UserId       string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NumQuizzes   int32                  `protobuf:"varint,2,opt,name=num_quizzes,json=numQuizzes,proto3" json:"num_quizzes,omitempty"`
+	AvgQuizRate  float32                `protobuf:"fixed32,3,opt,name=avg_quiz_rate,json=avgQuizRate,proto3" json:"avg_quiz_rate,omitempty"`
+	BestQuizRate float32                `protobuf:"fixed32,4,opt,name=best_quiz_rate,json=bestQuizRate,proto3" json:"best_quiz_rate,omitempty"`
+	// Place in the leaderboard, set only by ListAuthors.
+	Rank          int32 `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorStat) Reset() {
+	*x = AuthorStat{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorStat) ProtoMessage() {}
+
+func (x *AuthorStat) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorStat.ProtoReflect.Descriptor instead.
+func (*AuthorStat) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AuthorStat) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AuthorStat) GetNumQuizzes() int32 {
+	if x != nil {
+		return x.NumQuizzes
+	}
+	return 0
+}
+
+func (x *AuthorStat) GetAvgQuizRate() float32 {
+	if x != nil {
+		return x.AvgQuizRate
+	}
+	return 0
+}
+
+func (x *AuthorStat) GetBestQuizRate() float32 {
+	if x != nil {
+		return x.BestQuizRate
+	}
+	return 0
+}
+
+func (x *AuthorStat) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+type GetAuthorStatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuthorStatRequest) Reset() {
+	*x = GetAuthorStatRequest{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuthorStatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuthorStatRequest) ProtoMessage() {}
+
+func (x *GetAuthorStatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuthorStatRequest.ProtoReflect.Descriptor instead.
+func (*GetAuthorStatRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetAuthorStatRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetAuthorStatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Author        *AuthorStat            `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuthorStatResponse) Reset() {
+	*x = GetAuthorStatResponse{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuthorStatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuthorStatResponse) ProtoMessage() {}
+
+func (x *GetAuthorStatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuthorStatResponse.ProtoReflect.Descriptor instead.
+func (*GetAuthorStatResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetAuthorStatResponse) GetAuthor() *AuthorStat {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+type ListAuthorsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Option    ListAuthorsOption      `protobuf:"varint,1,opt,name=option,proto3,enum=api.ListAuthorsOption" json:"option,omitempty"`
+	Period    LeaderboardPeriod      `protobuf:"varint,2,opt,name=period,proto3,enum=api.LeaderboardPeriod" json:"period,omitempty"`
+	Direction SortDirection          `protobuf:"varint,3,opt,name=direction,proto3,enum=api.SortDirection" json:"direction,omitempty"`
+	// Defaults to 20, at most 100.
+	PageSize      int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuthorsRequest) Reset() {
+	*x = ListAuthorsRequest{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuthorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthorsRequest) ProtoMessage() {}
+
+func (x *ListAuthorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthorsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuthorsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListAuthorsRequest) GetOption() ListAuthorsOption {
+	if x != nil {
+		return x.Option
+	}
+	return ListAuthorsOption_NUM_QUIZZES
+}
+
+func (x *ListAuthorsRequest) GetPeriod() LeaderboardPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return LeaderboardPeriod_PERIOD_ALL_TIME
+}
+
+func (x *ListAuthorsRequest) GetDirection() SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return SortDirection_DIRECTION_DESC
+}
+
+func (x *ListAuthorsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuthorsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListAuthorsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Authors []*AuthorStat          `protobuf:"bytes,1,rep,name=authors,proto3" json:"authors,omitempty"`
+	// Empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The caller with their rank, wherever they are in the leaderboard.
+	// Missing without a bearer token or if the caller's quizzes had no activity in the period.
+	Me            *AuthorStat `protobuf:"bytes,3,opt,name=me,proto3" json:"me,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuthorsResponse) Reset() {
+	*x = ListAuthorsResponse{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuthorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthorsResponse) ProtoMessage() {}
+
+func (x *ListAuthorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthorsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuthorsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListAuthorsResponse) GetAuthors() []*AuthorStat {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+func (x *ListAuthorsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListAuthorsResponse) GetMe() *AuthorStat {
+	if x != nil {
+		return x.Me
+	}
+	return nil
+}
+
+type TeamResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          string                 `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	Score         float32                `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
+	Rank          int32                  `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	NumPlayers    int32                  `protobuf:"varint,4,opt,name=num_players,json=numPlayers,proto3" json:"num_players,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamResult) Reset() {
+	*x = TeamResult{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamResult) ProtoMessage() {}
+
+func (x *TeamResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamResult.ProtoReflect.Descriptor instead.
+func (*TeamResult) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TeamResult) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *TeamResult) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TeamResult) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *TeamResult) GetNumPlayers() int32 {
+	if x != nil {
+		return x.NumPlayers
+	}
+	return 0
+}
+
+type RecordTeamResultsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// room or challenge
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// Game ID within the source, a repeated call with the same ID changes nothing.
+	SourceId string `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	QuizId   string `protobuf:"bytes,3,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	// Host of the room or sender of the challenge; team names are unique per owner.
+	Owner         string        `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	Scoring       TeamScoring   `protobuf:"varint,5,opt,name=scoring,proto3,enum=api.TeamScoring" json:"scoring,omitempty"`
+	Teams         []*TeamResult `protobuf:"bytes,6,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordTeamResultsRequest) Reset() {
+	*x = RecordTeamResultsRequest{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordTeamResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordTeamResultsRequest) ProtoMessage() {}
+
+func (x *RecordTeamResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordTeamResultsRequest.ProtoReflect.Descriptor instead.
+func (*RecordTeamResultsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RecordTeamResultsRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *RecordTeamResultsRequest) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *RecordTeamResultsRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *RecordTeamResultsRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *RecordTeamResultsRequest) GetScoring() TeamScoring {
+	if x != nil {
+		return x.Scoring
+	}
+	return TeamScoring_TEAM_SCORING_AVERAGE
+}
+
+func (x *RecordTeamResultsRequest) GetTeams() []*TeamResult {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type RecordTeamResultsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordTeamResultsResponse) Reset() {
+	*x = RecordTeamResultsResponse{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordTeamResultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordTeamResultsResponse) ProtoMessage() {}
+
+func (x *RecordTeamResultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordTeamResultsResponse.ProtoReflect.Descriptor instead.
+func (*RecordTeamResultsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{32}
+}
+
+type TeamStat struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Owner       string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Team        string                 `protobuf:"bytes,2,opt,name=team,proto3" json:"team,omitempty"`
+	NumSessions int32                  `protobuf:"varint,3,opt,name=num_sessions,json=numSessions,proto3" json:"num_sessions,omitempty"`
+	// Games finished in first place, shared first places count.
+	Wins          int32   `protobuf:"varint,4,opt,name=wins,proto3" json:"wins,omitempty"`
+	AvgRank       float32 `protobuf:"fixed32,5,opt,name=avg_rank,json=avgRank,proto3" json:"avg_rank,omitempty"`
+	BestScore     float32 `protobuf:"fixed32,6,opt,name=best_score,json=bestScore,proto3" json:"best_score,omitempty"`
+	AvgScore      float32 `protobuf:"fixed32,7,opt,name=avg_score,json=avgScore,proto3" json:"avg_score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamStat) Reset() {
+	*x = TeamStat{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamStat) ProtoMessage() {}
+
+func (x *TeamStat) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamStat.ProtoReflect.Descriptor instead.
+func (*TeamStat) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *TeamStat) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *TeamStat) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *TeamStat) GetNumSessions() int32 {
+	if x != nil {
+		return x.NumSessions
+	}
+	return 0
+}
+
+func (x *TeamStat) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *TeamStat) GetAvgRank() float32 {
+	if x != nil {
+		return x.AvgRank
+	}
+	return 0
+}
+
+func (x *TeamStat) GetBestScore() float32 {
+	if x != nil {
+		return x.BestScore
+	}
+	return 0
+}
+
+func (x *TeamStat) GetAvgScore() float32 {
+	if x != nil {
+		return x.AvgScore
+	}
+	return 0
+}
+
+type TeamHistoryEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	SourceId      string                 `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	QuizId        string                 `protobuf:"bytes,3,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	Score         float32                `protobuf:"fixed32,4,opt,name=score,proto3" json:"score,omitempty"`
+	Rank          int32                  `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"`
+	NumTeams      int32                  `protobuf:"varint,6,opt,name=num_teams,json=numTeams,proto3" json:"num_teams,omitempty"`
+	NumPlayers    int32                  `protobuf:"varint,7,opt,name=num_players,json=numPlayers,proto3" json:"num_players,omitempty"`
+	Scoring       TeamScoring            `protobuf:"varint,8,opt,name=scoring,proto3,enum=api.TeamScoring" json:"scoring,omitempty"`
+	PlayedAt      string                 `protobuf:"bytes,9,opt,name=played_at,json=playedAt,proto3" json:"played_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamHistoryEntry) Reset() {
+	*x = TeamHistoryEntry{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamHistoryEntry) ProtoMessage() {}
+
+func (x *TeamHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamHistoryEntry.ProtoReflect.Descriptor instead.
+func (*TeamHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TeamHistoryEntry) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *TeamHistoryEntry) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *TeamHistoryEntry) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *TeamHistoryEntry) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TeamHistoryEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *TeamHistoryEntry) GetNumTeams() int32 {
+	if x != nil {
+		return x.NumTeams
+	}
+	return 0
+}
+
+func (x *TeamHistoryEntry) GetNumPlayers() int32 {
+	if x != nil {
+		return x.NumPlayers
+	}
+	return 0
+}
+
+func (x *TeamHistoryEntry) GetScoring() TeamScoring {
+	if x != nil {
+		return x.Scoring
+	}
+	return TeamScoring_TEAM_SCORING_AVERAGE
+}
+
+func (x *TeamHistoryEntry) GetPlayedAt() string {
+	if x != nil {
+		return x.PlayedAt
+	}
+	return ""
+}
+
+type GetTeamStatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Owner         string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Team          string                 `protobuf:"bytes,2,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamStatRequest) Reset() {
+	*x = GetTeamStatRequest{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamStatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamStatRequest) ProtoMessage() {}
+
+func (x *GetTeamStatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamStatRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamStatRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetTeamStatRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *GetTeamStatRequest) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+type GetTeamStatResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Team  *TeamStat              `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	// The latest games first.
+	History       []*TeamHistoryEntry `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamStatResponse) Reset() {
+	*x = GetTeamStatResponse{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamStatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamStatResponse) ProtoMessage() {}
+
+func (x *GetTeamStatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamStatResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamStatResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetTeamStatResponse) GetTeam() *TeamStat {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *GetTeamStatResponse) GetHistory() []*TeamHistoryEntry {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type ListTeamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Option        ListTeamsOption        `protobuf:"varint,1,opt,name=option,proto3,enum=api.ListTeamsOption" json:"option,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamsRequest) Reset() {
+	*x = ListTeamsRequest{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsRequest) ProtoMessage() {}
+
+func (x *ListTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsRequest.ProtoReflect.Descriptor instead.
+func (*ListTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListTeamsRequest) GetOption() ListTeamsOption {
+	if x != nil {
+		return x.Option
+	}
+	return ListTeamsOption_TEAM_WINS
+}
+
+type ListTeamsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         []*TeamStat            `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamsResponse) Reset() {
+	*x = ListTeamsResponse{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsResponse) ProtoMessage() {}
+
+func (x *ListTeamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsResponse.ProtoReflect.Descriptor instead.
+func (*ListTeamsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListTeamsResponse) GetTeams() []*TeamStat {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type DailyStreak struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Zero once a day is missed.
+	CurrentStreak int32 `protobuf:"varint,2,opt,name=current_streak,json=currentStreak,proto3" json:"current_streak,omitempty"`
+	BestStreak    int32 `protobuf:"varint,3,opt,name=best_streak,json=bestStreak,proto3" json:"best_streak,omitempty"`
+	DaysPlayed    int32 `protobuf:"varint,4,opt,name=days_played,json=daysPlayed,proto3" json:"days_played,omitempty"`
+	// Last day played as YYYY-MM-DD in UTC, empty if never played.
+	LastPlayed    string `protobuf:"bytes,5,opt,name=last_played,json=lastPlayed,proto3" json:"last_played,omitempty"`
+	PlayedToday   bool   `protobuf:"varint,6,opt,name=played_today,json=playedToday,proto3" json:"played_today,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyStreak) Reset() {
+	*x = DailyStreak{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyStreak) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyStreak) ProtoMessage() {}
+
+func (x *DailyStreak) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyStreak.ProtoReflect.Descriptor instead.
+func (*DailyStreak) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DailyStreak) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DailyStreak) GetCurrentStreak() int32 {
+	if x != nil {
+		return x.CurrentStreak
+	}
+	return 0
+}
+
+func (x *DailyStreak) GetBestStreak() int32 {
+	if x != nil {
+		return x.BestStreak
+	}
+	return 0
+}
+
+func (x *DailyStreak) GetDaysPlayed() int32 {
+	if x != nil {
+		return x.DaysPlayed
+	}
+	return 0
+}
+
+func (x *DailyStreak) GetLastPlayed() string {
+	if x != nil {
+		return x.LastPlayed
+	}
+	return ""
+}
+
+func (x *DailyStreak) GetPlayedToday() bool {
+	if x != nil {
+		return x.PlayedToday
+	}
+	return false
+}
+
+type GetDailyStreakRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDailyStreakRequest) Reset() {
+	*x = GetDailyStreakRequest{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDailyStreakRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyStreakRequest) ProtoMessage() {}
+
+func (x *GetDailyStreakRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyStreakRequest.ProtoReflect.Descriptor instead.
+func (*GetDailyStreakRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetDailyStreakRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetDailyStreakResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Streak        *DailyStreak           `protobuf:"bytes,1,opt,name=streak,proto3" json:"streak,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDailyStreakResponse) Reset() {
+	*x = GetDailyStreakResponse{}
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDailyStreakResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyStreakResponse) ProtoMessage() {}
+
+func (x *GetDailyStreakResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_statistics_stat_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyStreakResponse.ProtoReflect.Descriptor instead.
+func (*GetDailyStreakResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_statistics_stat_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetDailyStreakResponse) GetStreak() *DailyStreak {
+	if x != nil {
+		return x.Streak
+	}
+	return nil
+}
+
+var File_api_proto_statistics_stat_service_proto protoreflect.FileDescriptor
+
+var file_api_proto_statistics_stat_service_proto_rawDesc = string([]byte{
+	0x0a, 0x27, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x61, 0x70, 0x69, 0x1a, 0x20,
+	0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xf3, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x71, 0x75, 0x69, 0x7a, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x71, 0x75, 0x69, 0x7a, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1f, 0x0a,
+	0x09, 0x71, 0x75, 0x69, 0x7a, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02,
+	0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x71, 0x75, 0x69, 0x7a, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a,
+	0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x5f, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
+	0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x22, 0xf2, 0x01, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x71, 0x75, 0x69, 0x7a,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x71, 0x75, 0x69, 0x7a, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x71, 0x75, 0x69, 0x7a, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x71, 0x75, 0x69, 0x7a, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x41, 0x74, 0x22, 0x70, 0x0a, 0x19,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6e,
+	0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x71, 0x75, 0x69,
+	0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x71, 0x75, 0x69, 0x7a,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6e,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xb3,
+	0x01, 0x0a, 0x08, 0x51, 0x75, 0x69, 0x7a, 0x53, 0x74, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x71,
+	0x75, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x71, 0x75,
+	0x69, 0x7a, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x76, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x61, 0x76, 0x67, 0x52, 0x61, 0x74, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x72, 0x61, 0x6e, 0x6b, 0x22, 0x2d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x53,
+	0x74, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x71, 0x75,
+	0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x71, 0x75, 0x69,
+	0x7a, 0x49, 0x64, 0x22, 0x38, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x53, 0x74,
+	0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x71, 0x75,
+	0x69, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x51,
+	0x75, 0x69, 0x7a, 0x53, 0x74, 0x61, 0x74, 0x52, 0x04, 0x71, 0x75, 0x69, 0x7a, 0x22, 0xe2, 0x01,
+	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x7a, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51,
+	0x75, 0x69, 0x7a, 0x7a, 0x65, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x12, 0x30, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f,
+	0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x89, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x7a,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x71, 0x75,
+	0x69, 0x7a, 0x7a, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x53, 0x74, 0x61, 0x74, 0x52, 0x07, 0x71, 0x75, 0x69, 0x7a,
+	0x7a, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x04, 0x6d,
+	0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x51, 0x75, 0x69, 0x7a, 0x53, 0x74, 0x61, 0x74, 0x52, 0x04, 0x6d, 0x69, 0x6e, 0x65, 0x22, 0x89,
+	0x01, 0x0a, 0x0a, 0x51, 0x75, 0x69, 0x7a, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x0a,
+	0x07, 0x71, 0x75, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x71, 0x75, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x72,
+	0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x56, 0x0a, 0x0f, 0x52, 0x61,
+	0x74, 0x65, 0x51, 0x75, 0x69, 0x7a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x71, 0x75, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x71, 0x75, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x22, 0x5e, 0x0a, 0x10, 0x52, 0x61, 0x74, 0x65, 0x51, 0x75, 0x69, 0x7a, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x51, 0x75, 0x69,
+	0x7a, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x21, 0x0a, 0x04, 0x71, 0x75, 0x69, 0x7a, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x53, 0x74, 0x61, 0x74, 0x52, 0x04, 0x71, 0x75,
+	0x69, 0x7a, 0x22, 0x6d, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x52, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x71, 0x75, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x71,
+	0x75, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x6c, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x52, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07,
+	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07,
+	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x73, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x50, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x50, 0x69,
+	0x63, 0x6b, 0x65, 0x64, 0x22, 0x83, 0x02, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x61, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e, 0x75, 0x6d,
+	0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0e, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74,
+	0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x76, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x76, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73,
+	0x12, 0x35, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x63, 0x72,
+	0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x13, 0x64, 0x69, 0x73, 0x63, 0x72, 0x69, 0x6d, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x32, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x71, 0x75, 0x69, 0x7a, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x71, 0x75, 0x69, 0x7a, 0x49, 0x64, 0x22, 0x87,
+	0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x71,
+	0x75, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x71, 0x75,
+	0x69, 0x7a, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x52, 0x09, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb9, 0x01, 0x0a, 0x0a, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x62, 0x65, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x61, 0x76, 0x67, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x08, 0x61, 0x76, 0x67, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x72, 0x61, 0x6e, 0x6b, 0x22, 0x2f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x40, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27,
+	0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x52,
+	0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0xe2, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e,
+	0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e,
+	0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x30,
+	0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x89, 0x01, 0x0a,
+	0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x02, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x52, 0x02, 0x6d, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x0a, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x71, 0x75, 0x69, 0x7a, 0x7a, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x51, 0x75, 0x69, 0x7a, 0x7a, 0x65,
+	0x73, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x76, 0x67, 0x5f, 0x71, 0x75, 0x69, 0x7a, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x61, 0x76, 0x67, 0x51, 0x75, 0x69,
+	0x7a, 0x52, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x71, 0x75,
+	0x69, 0x7a, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x62,
+	0x65, 0x73, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x52, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22,
+	0x2f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x40, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x22, 0xe2, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x06, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x30, 0x0a, 0x09, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x89, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x29, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x02, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x52,
+	0x02, 0x6d, 0x65, 0x22, 0x6b, 0x0a, 0x0a, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x65, 0x61, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x22, 0xd1, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x71, 0x75, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x71, 0x75, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x2a, 0x0a, 0x07, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x53, 0x63, 0x6f,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a,
+	0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x05, 0x74,
+	0x65, 0x61, 0x6d, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xc2, 0x01, 0x0a, 0x08, 0x54, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x6e, 0x75, 0x6d, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x77,
+	0x69, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x12,
+	0x19, 0x0a, 0x08, 0x61, 0x76, 0x67, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x07, 0x61, 0x76, 0x67, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x65,
+	0x73, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09,
+	0x62, 0x65, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x76, 0x67,
+	0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x61, 0x76,
+	0x67, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x91, 0x02, 0x0a, 0x10, 0x54, 0x65, 0x61, 0x6d, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x71, 0x75, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x71, 0x75, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x54, 0x65, 0x61, 0x6d, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x12, 0x2a, 0x0a, 0x07, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x53, 0x63, 0x6f,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x41, 0x74, 0x22, 0x3e, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x54, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x22, 0x69, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x54, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x21, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x52, 0x04,
+	0x74, 0x65, 0x61, 0x6d, 0x12, 0x2f, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x65, 0x61, 0x6d,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x40, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x61,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x38, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05,
+	0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d,
+	0x73, 0x22, 0xd3, 0x01, 0x0a, 0x0b, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6b, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x65, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x79, 0x73, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x61, 0x79, 0x73, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x5f, 0x74,
+	0x6f, 0x64, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x64, 0x54, 0x6f, 0x64, 0x61, 0x79, 0x22, 0x30, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x61,
+	0x69, 0x6c, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x44, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6b, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x2a, 0x61, 0x0a,
+	0x11, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x41, 0x4c, 0x4c,
+	0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x45, 0x52, 0x49, 0x4f,
+	0x44, 0x5f, 0x44, 0x41, 0x49, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x45, 0x52,
+	0x49, 0x4f, 0x44, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x4c, 0x59, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e,
+	0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x4c, 0x59, 0x10, 0x03,
+	0x2a, 0x36, 0x0a, 0x0d, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44,
+	0x45, 0x53, 0x43, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x01, 0x2a, 0x33, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
+	0x51, 0x75, 0x69, 0x7a, 0x7a, 0x65, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x0a,
+	0x08, 0x41, 0x56, 0x47, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4e,
+	0x55, 0x4d, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x01, 0x2a, 0x43, 0x0a,
+	0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x53, 0x43, 0x4f, 0x52,
+	0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x43, 0x4f, 0x52,
+	0x45, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x56, 0x47, 0x5f, 0x53, 0x43, 0x4f, 0x52, 0x45,
+	0x10, 0x02, 0x2a, 0x4b, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x55, 0x4d, 0x5f, 0x51,
+	0x55, 0x49, 0x5a, 0x5a, 0x45, 0x53, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x56, 0x47, 0x5f,
+	0x51, 0x55, 0x49, 0x5a, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x42,
+	0x45, 0x53, 0x54, 0x5f, 0x51, 0x55, 0x49, 0x5a, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10, 0x02, 0x2a,
+	0x3d, 0x0a, 0x0b, 0x54, 0x65, 0x61, 0x6d, 0x53, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x18,
+	0x0a, 0x14, 0x54, 0x45, 0x41, 0x4d, 0x5f, 0x53, 0x43, 0x4f, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x41,
+	0x56, 0x45, 0x52, 0x41, 0x47, 0x45, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x45, 0x41, 0x4d,
+	0x5f, 0x53, 0x43, 0x4f, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x55, 0x4d, 0x10, 0x01, 0x2a, 0x4a,
+	0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x45, 0x41, 0x4d, 0x5f, 0x57, 0x49, 0x4e, 0x53, 0x10, 0x00,
+	0x12, 0x11, 0x0a, 0x0d, 0x54, 0x45, 0x41, 0x4d, 0x5f, 0x41, 0x56, 0x47, 0x5f, 0x52, 0x41, 0x4e,
+	0x4b, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x45, 0x41, 0x4d, 0x5f, 0x4e, 0x55, 0x4d, 0x5f,
+	0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x02, 0x32, 0xb5, 0x0d, 0x0a, 0x0a, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x5d, 0x0a, 0x0b, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x7c, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x25, 0x12, 0x23, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2f, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x76, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75,
+	0x69, 0x7a, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2f, 0x71, 0x75, 0x69, 0x7a, 0x2f, 0x7b, 0x71, 0x75, 0x69,
+	0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x62,
+	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x53, 0x74, 0x61, 0x74, 0x12, 0x17, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x53, 0x74, 0x61, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x51, 0x75, 0x69, 0x7a, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x2f, 0x71, 0x75, 0x69, 0x7a, 0x2f, 0x7b, 0x71, 0x75, 0x69, 0x7a, 0x5f, 0x69,
+	0x64, 0x7d, 0x12, 0x64, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x7a, 0x65,
+	0x73, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x69, 0x7a,
+	0x7a, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x7a, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2f, 0x71, 0x75, 0x69, 0x7a, 0x7a, 0x65, 0x73, 0x2f,
+	0x7b, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x7d, 0x12, 0x63, 0x0a, 0x08, 0x52, 0x61, 0x74, 0x65,
+	0x51, 0x75, 0x69, 0x7a, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x51,
+	0x75, 0x69, 0x7a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x52, 0x61, 0x74, 0x65, 0x51, 0x75, 0x69, 0x7a, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2f, 0x71, 0x75, 0x69, 0x7a, 0x2f, 0x7b, 0x71, 0x75,
+	0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x76, 0x0a,
+	0x0f, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x52,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2f, 0x71,
+	0x75, 0x69, 0x7a, 0x2f, 0x7b, 0x71, 0x75, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x7b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
+	0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2f, 0x71, 0x75, 0x69, 0x7a, 0x2f, 0x7b,
+	0x71, 0x75, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x6a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2f, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x64,
+	0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x17, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x2f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x7d, 0x12, 0x6a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2f,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d,
+	0x12, 0x64, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x12,
+	0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x7d, 0x12, 0x54, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x12, 0x17, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65,
+	0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x2f, 0x7b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x7d, 0x2f, 0x7b,
+	0x74, 0x65, 0x61, 0x6d, 0x7d, 0x12, 0x5c, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x61,
+	0x6d, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x61,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x2f, 0x7b, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x7d, 0x12, 0x6d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x44,
+	0x61, 0x69, 0x6c, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x7d, 0x42, 0x0c, 0x5a, 0x0a, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_api_proto_statistics_stat_service_proto_rawDescOnce sync.Once
+	file_api_proto_statistics_stat_service_proto_rawDescData []byte
+)
+
+func file_api_proto_statistics_stat_service_proto_rawDescGZIP() []byte {
+	file_api_proto_statistics_stat_service_proto_rawDescOnce.Do(func() {
+		file_api_proto_statistics_stat_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_statistics_stat_service_proto_rawDesc), len(file_api_proto_statistics_stat_service_proto_rawDesc)))
+	})
+	return file_api_proto_statistics_stat_service_proto_rawDescData
+}
+
+var file_api_proto_statistics_stat_service_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_api_proto_statistics_stat_service_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
+var file_api_proto_statistics_stat_service_proto_goTypes = []any{
+	(LeaderboardPeriod)(0),            // 0: api.LeaderboardPeriod
+	(SortDirection)(0),                // 1: api.SortDirection
+	(ListQuizzesOption)(0),            // 2: api.ListQuizzesOption
+	(ListPlayersOption)(0),            // 3: api.ListPlayersOption
+	(ListAuthorsOption)(0),            // 4: api.ListAuthorsOption
+	(TeamScoring)(0),                  // 5: api.TeamScoring
+	(ListTeamsOption)(0),              // 6: api.ListTeamsOption
+	(*UpdateStatsRequest)(nil),        // 7: api.UpdateStatsRequest
+	(*UpdateStatsResponse)(nil),       // 8: api.UpdateStatsResponse
+	(*PlayedSession)(nil),             // 9: api.PlayedSession
+	(*ListPlayerSessionsRequest)(nil), // 10: api.ListPlayerSessionsRequest
+	(*ListQuizSessionsRequest)(nil),   // 11: api.ListQuizSessionsRequest
+	(*ListSessionsResponse)(nil),      // 12: api.ListSessionsResponse
+	(*QuizStat)(nil),                  // 13: api.QuizStat
+	(*GetQuizStatRequest)(nil),        // 14: api.GetQuizStatRequest
+	(*GetQuizStatResponse)(nil),       // 15: api.GetQuizStatResponse
+	(*ListQuizzesRequest)(nil),        // 16: api.ListQuizzesRequest
+	(*ListQuizzesResponse)(nil),       // 17: api.ListQuizzesResponse
+	(*QuizRating)(nil),                // 18: api.QuizRating
+	(*RateQuizRequest)(nil),           // 19: api.RateQuizRequest
+	(*RateQuizResponse)(nil),          // 20: api.RateQuizResponse
+	(*ListQuizRatingsRequest)(nil),    // 21: api.ListQuizRatingsRequest
+	(*ListQuizRatingsResponse)(nil),   // 22: api.ListQuizRatingsResponse
+	(*DistractorStat)(nil),            // 23: api.DistractorStat
+	(*QuestionStat)(nil),              // 24: api.QuestionStat
+	(*GetQuestionStatsRequest)(nil),   // 25: api.GetQuestionStatsRequest
+	(*GetQuestionStatsResponse)(nil),  // 26: api.GetQuestionStatsResponse
+	(*PlayerStat)(nil),                // 27: api.PlayerStat
+	(*GetPlayerStatRequest)(nil),      // 28: api.GetPlayerStatRequest
+	(*GetPlayerStatResponse)(nil),     // 29: api.GetPlayerStatResponse
+	(*ListPlayersRequest)(nil),        // 30: api.ListPlayersRequest
+	(*ListPlayersResponse)(nil),       // 31: api.ListPlayersResponse
+	(*AuthorStat)(nil),                // 32: api.AuthorStat
+	(*GetAuthorStatRequest)(nil),      // 33: api.GetAuthorStatRequest
+	(*GetAuthorStatResponse)(nil),     // 34: api.GetAuthorStatResponse
+	(*ListAuthorsRequest)(nil),        // 35: api.ListAuthorsRequest
+	(*ListAuthorsResponse)(nil),       // 36: api.ListAuthorsResponse
+	(*TeamResult)(nil),                // 37: api.TeamResult
+	(*RecordTeamResultsRequest)(nil),  // 38: api.RecordTeamResultsRequest
+	(*RecordTeamResultsResponse)(nil), // 39: api.RecordTeamResultsResponse
+	(*TeamStat)(nil),                  // 40: api.TeamStat
+	(*TeamHistoryEntry)(nil),          // 41: api.TeamHistoryEntry
+	(*GetTeamStatRequest)(nil),        // 42: api.GetTeamStatRequest
+	(*GetTeamStatResponse)(nil),       // 43: api.GetTeamStatResponse
+	(*ListTeamsRequest)(nil),          // 44: api.ListTeamsRequest
+	(*ListTeamsResponse)(nil),         // 45: api.ListTeamsResponse
+	(*DailyStreak)(nil),               // 46: api.DailyStreak
+	(*GetDailyStreakRequest)(nil),     // 47: api.GetDailyStreakRequest
+	(*GetDailyStreakResponse)(nil),    // 48: api.GetDailyStreakResponse
+}
+var file_api_proto_statistics_stat_service_proto_depIdxs = []int32{
+	9,  // 0: api.UpdateStatsResponse.session:type_name -> api.PlayedSession
+	9,  // 1: api.ListSessionsResponse.sessions:type_name -> api.PlayedSession
+	13, // 2: api.GetQuizStatResponse.quiz:type_name -> api.QuizStat
+	2,  // 3: api.ListQuizzesRequest.option:type_name -> api.ListQuizzesOption
+	0,  // 4: api.ListQuizzesRequest.period:type_name -> api.LeaderboardPeriod
+	1,  // 5: api.ListQuizzesRequest.direction:type_name -> api.SortDirection
+	13, // 6: api.ListQuizzesResponse.quizzes:type_name -> api.QuizStat
+	13, // 7: api.ListQuizzesResponse.mine:type_name -> api.QuizStat
+	18, // 8: api.RateQuizResponse.rating:type_name -> api.QuizRating
+	13, // 9: api.RateQuizResponse.quiz:type_name -> api.QuizStat
+	18, // 10: api.ListQuizRatingsResponse.ratings:type_name -> api.QuizRating
+	23, // 11: api.QuestionStat.distractors:type_name -> api.DistractorStat
+	24, // 12: api.GetQuestionStatsResponse.questions:type_name -> api.QuestionStat
+	27, // 13: api.GetPlayerStatResponse.player:type_name -> api.PlayerStat
+	3,  // 14: api.ListPlayersRequest.option:type_name -> api.ListPlayersOption
+	0,  // 15: api.ListPlayersRequest.period:type_name -> api.LeaderboardPeriod
+	1,  // 16: api.ListPlayersRequest.direction:type_name -> api.SortDirection
+	27, // 17: api.ListPlayersResponse.players:type_name -> api.PlayerStat
+	27, // 18: api.ListPlayersResponse.me:type_name -> api.PlayerStat
+	32, // 19: api.GetAuthorStatResponse.author:type_name -> api.AuthorStat
+	4,  // 20: api.ListAuthorsRequest.option:type_name -> api.ListAuthorsOption
+	0,  // 21: api.ListAuthorsRequest.period:type_name -> api.LeaderboardPeriod
+	1,  // 22: api.ListAuthorsRequest.direction:type_name -> api.SortDirection
+	32, // 23: api.ListAuthorsResponse.authors:type_name -> api.AuthorStat
+	32, // 24: api.ListAuthorsResponse.me:type_name -> api.AuthorStat
+	5,  // 25: api.RecordTeamResultsRequest.scoring:type_name -> api.TeamScoring
+	37, // 26: api.RecordTeamResultsRequest.teams:type_name -> api.TeamResult
+	5,  // 27: api.TeamHistoryEntry.scoring:type_name -> api.TeamScoring
+	40, // 28: api.GetTeamStatResponse.team:type_name -> api.TeamStat
+	41, // 29: api.GetTeamStatResponse.history:type_name -> api.TeamHistoryEntry
+	6,  // 30: api.ListTeamsRequest.option:type_name -> api.ListTeamsOption
+	40, // 31: api.ListTeamsResponse.teams:type_name -> api.TeamStat
+	46, // 32: api.GetDailyStreakResponse.streak:type_name -> api.DailyStreak
+	7,  // 33: api.Statistics.UpdateStats:input_type -> api.UpdateStatsRequest
+	10, // 34: api.Statistics.ListPlayerSessions:input_type -> api.ListPlayerSessionsRequest
+	11, // 35: api.Statistics.ListQuizSessions:input_type -> api.ListQuizSessionsRequest
+	14, // 36: api.Statistics.GetQuizStat:input_type -> api.GetQuizStatRequest
+	16, // 37: api.Statistics.ListQuizzes:input_type -> api.ListQuizzesRequest
+	19, // 38: api.Statistics.RateQuiz:input_type -> api.RateQuizRequest
+	21, // 39: api.Statistics.ListQuizRatings:input_type -> api.ListQuizRatingsRequest
+	25, // 40: api.Statistics.GetQuestionStats:input_type -> api.GetQuestionStatsRequest
+	28, // 41: api.Statistics.GetPlayerStat:input_type -> api.GetPlayerStatRequest
+	30, // 42: api.Statistics.ListPlayers:input_type -> api.ListPlayersRequest
+	33, // 43: api.Statistics.GetAuthorStat:input_type -> api.GetAuthorStatRequest
+	35, // 44: api.Statistics.ListAuthors:input_type -> api.ListAuthorsRequest
+	38, // 45: api.Statistics.RecordTeamResults:input_type -> api.RecordTeamResultsRequest
+	42, // 46: api.Statistics.GetTeamStat:input_type -> api.GetTeamStatRequest
+	44, // 47: api.Statistics.ListTeams:input_type -> api.ListTeamsRequest
+	47, // 48: api.Statistics.GetDailyStreak:input_type -> api.GetDailyStreakRequest
+	8,  // 49: api.Statistics.UpdateStats:output_type -> api.UpdateStatsResponse
+	12, // 50: api.Statistics.ListPlayerSessions:output_type -> api.ListSessionsResponse
+	12, // 51: api.Statistics.ListQuizSessions:output_type -> api.ListSessionsResponse
+	15, // 52: api.Statistics.GetQuizStat:output_type -> api.GetQuizStatResponse
+	17, // 53: api.Statistics.ListQuizzes:output_type -> api.ListQuizzesResponse
+	20, // 54: api.Statistics.RateQuiz:output_type -> api.RateQuizResponse
+	22, // 55: api.Statistics.ListQuizRatings:output_type -> api.ListQuizRatingsResponse
+	26, // 56: api.Statistics.GetQuestionStats:output_type -> api.GetQuestionStatsResponse
+	29, // 57: api.Statistics.GetPlayerStat:output_type -> api.GetPlayerStatResponse
+	31, // 58: api.Statistics.ListPlayers:output_type -> api.ListPlayersResponse
+	34, // 59: api.Statistics.GetAuthorStat:output_type -> api.GetAuthorStatResponse
+	36, // 60: api.Statistics.ListAuthors:output_type -> api.ListAuthorsResponse
+	39, // 61: api.Statistics.RecordTeamResults:output_type -> api.RecordTeamResultsResponse
+	43, // 62: api.Statistics.GetTeamStat:output_type -> api.GetTeamStatResponse
+	45, // 63: api.Statistics.ListTeams:output_type -> api.ListTeamsResponse
+	48, // 64: api.Statistics.GetDailyStreak:output_type -> api.GetDailyStreakResponse
+	49, // [49:65] is the sub-list for method output_type
+	33, // [33:49] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_statistics_stat_service_proto_init() }
+func file_api_proto_statistics_stat_service_proto_init() {
+	if File_api_proto_statistics_stat_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_statistics_stat_service_proto_rawDesc), len(file_api_proto_statistics_stat_service_proto_rawDesc)),
+			NumEnums:      7,
+			NumMessages:   42,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_proto_statistics_stat_service_proto_goTypes,
+		DependencyIndexes: file_api_proto_statistics_stat_service_proto_depIdxs,
+		EnumInfos:         file_api_proto_statistics_stat_service_proto_enumTypes,
+		MessageInfos:      file_api_proto_statistics_stat_service_proto_msgTypes,
+	}.Build()
+	File_api_proto_statistics_stat_service_proto = out.File
+	file_api_proto_statistics_stat_service_proto_goTypes = nil
+	file_api_proto_statistics_stat_service_proto_depIdxs = nil
+}
@@ -0,0 +1,8 @@
+// Адрес картинки из quiz_service. Старые квизы хранят в image_id готовый URL.
+export const imageUrl = (imageId, thumbnail = false) => {
+  if (!imageId || /^(https?:|data:)/.test(imageId)) {
+    return imageId
+  }
+  const url = `http://localhost:8085/v1/quiz/images/${imageId}`
+  return thumbnail ? `${url}?thumbnail=true` : url
+}
@@ -7,14 +7,19 @@ import (
 	"os"
 	"os/signal"
 	"quizzes/internal/config"
+	"quizzes/internal/quizzes/daily"
 	"quizzes/internal/quizzes/quizzes/service"
 	"quizzes/internal/quizzes/repository"
 	v1 "quizzes/pkg/api/v1"
 	"quizzes/pkg/logger"
+	"quizzes/pkg/media"
+	"quizzes/pkg/rooms"
+	statapi "quizzes/pkg/statapi/v1"
 	"strconv"
 	"syscall"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -45,14 +50,36 @@ func (app *Application) Run(ctx context.Context) {
 	l.Info(ctx, "Start quiz service")
 	repo := repository.NewRepository(ctx, cfg)
 	defer repo.CloseConn()
-	service := service.New(ctx, repo)
+	images, err := media.New(cfg.Media)
+	if err != nil {
+		l.Fatal(ctx, err.Error())
+	}
+	roomStore, err := rooms.New(ctx, cfg.Rooms)
+	if err != nil {
+		l.Fatal(ctx, err.Error())
+	}
+	statConn, err := grpc.NewClient(cfg.StatAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		l.Fatal(ctx, err.Error())
+	}
+	defer statConn.Close()
+	stats := statapi.NewStatisticsClient(statConn)
+	rooms := service.NewRooms(repo, roomStore, stats, l)
+	scheduler, err := daily.New(cfg.Daily, repo, stats, l)
+	if err != nil {
+		l.Fatal(ctx, err.Error())
+	}
+	go scheduler.Run(ctx)
+	service := service.New(ctx, repo, images, cfg.Media.MaxSize, scheduler)
 	lis, err := net.Listen("tcp", ":"+strconv.Itoa(cfg.GRPCPort))
 	if err != nil {
 		l.Fatal(ctx, err.Error())
 	}
 	l.Info(ctx, "Listen on port "+strconv.Itoa(cfg.GRPCPort))
-	grpcServer := grpc.NewServer()
+	// Картинка приходит одним сообщением, лимит gRPC должен ее вмещать
+	grpcServer := grpc.NewServer(grpc.MaxRecvMsgSize(cfg.Media.MaxSize + 1<<16))
 	v1.RegisterQuizServiceServer(grpcServer, service)
+	v1.RegisterRoomServiceServer(grpcServer, rooms)
 	reflection.Register(grpcServer)
 	go func() {
 		if err := grpcServer.Serve(lis); err != nil && err != grpc.ErrServerStopped {
@@ -2,7 +2,10 @@ package config
 
 import (
 	"path/filepath"
+	"quizzes/internal/quizzes/daily"
+	"quizzes/pkg/media"
 	"quizzes/pkg/postgres"
+	"quizzes/pkg/rooms"
 	"runtime"
 
 	"github.com/ilyakaznacheev/cleanenv"
@@ -13,6 +16,11 @@ type Config struct {
 	Host     string          `yaml:"HOST" env:"HOST" env-default:"127.0.0.1"`
 	GRPCPort int             `yaml:"GRPC_PORT" env:"GRPC_PORT" env-default:"50053"`
 	HTTPPort int             `yaml:"HTTP_PORT" env:"HTTP_PORT" env-default:"8080"`
+	AuthAddr string          `yaml:"AUTH_ADDR" env:"AUTH_ADDR" env-default:"auth_service:50052"`
+	StatAddr string          `yaml:"STAT_ADDR" env:"STAT_ADDR" env-default:"stat_service:50051"`
+	Media    media.Config    `yaml:"MEDIA" env:"MEDIA"`
+	Rooms    rooms.Config    `yaml:"ROOMS" env:"ROOMS"`
+	Daily    daily.Config    `yaml:"DAILY" env:"DAILY"`
 }
 
 func getProjectRoot() string {
@@ -0,0 +1,166 @@
+package daily
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"quizzes/pkg/logger"
+	statapi "quizzes/pkg/statapi/v1"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// Способы выбора квиза дня
+const (
+	StrategyQueue    = "queue"
+	StrategyRandom   = "random"
+	StrategyTopRated = "top_rated"
+)
+
+// retryDelay - пауза перед повторным выбором, если выбрать квиз не удалось
+const retryDelay = time.Minute
+
+// ratingsPageSize - размер страницы рейтинга квизов из stat_service, наибольший допустимый
+const ratingsPageSize = 100
+
+type Config struct {
+	Strategy    string   `yaml:"DAILY_STRATEGY" env:"DAILY_STRATEGY" env-default:"random"`
+	Queue       []string `yaml:"DAILY_QUEUE" env:"DAILY_QUEUE" env-separator:","`
+	RepeatAfter int      `yaml:"DAILY_REPEAT_AFTER" env:"DAILY_REPEAT_AFTER" env-default:"30"`
+}
+
+var ErrNoCandidates = errors.New("no quiz can be the quiz of the day")
+
+type Repository interface {
+	DailyQuiz(ctx context.Context, day time.Time) (string, string, error)
+	DailyCandidates(ctx context.Context, day time.Time, repeatAfter int) ([]string, error)
+	SetDailyQuiz(ctx context.Context, day time.Time, quizID string, strategy string) (string, string, error)
+}
+
+// Ratings отдает рейтинги квизов из stat_service
+type Ratings interface {
+	ListQuizzes(context.Context, *statapi.ListQuizzesRequest, ...grpc.CallOption) (*statapi.ListQuizzesResponse, error)
+}
+
+// Scheduler выбирает квиз дня. Выбор хранится в базе, поэтому все экземпляры сервиса
+// и все игроки получают один и тот же квиз.
+type Scheduler struct {
+	cfg     Config
+	repo    Repository
+	ratings Ratings
+	l       *logger.Logger
+}
+
+func New(cfg Config, repo Repository, ratings Ratings, l *logger.Logger) (*Scheduler, error) {
+	switch cfg.Strategy {
+	case StrategyQueue, StrategyRandom, StrategyTopRated:
+	default:
+		return nil, fmt.Errorf("unknown daily strategy %q", cfg.Strategy)
+	}
+	if cfg.RepeatAfter < 0 {
+		return nil, fmt.Errorf("daily repeat_after must not be negative")
+	}
+	return &Scheduler{cfg: cfg, repo: repo, ratings: ratings, l: l}, nil
+}
+
+// Today возвращает текущий день по UTC
+func Today(now time.Time) time.Time {
+	y, m, d := now.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// Quiz возвращает квиз дня day и способ его выбора, выбирая его при первом обращении
+func (s *Scheduler) Quiz(ctx context.Context, day time.Time) (string, string, error) {
+	quizID, strategy, err := s.repo.DailyQuiz(ctx, day)
+	if err != nil || quizID != "" {
+		return quizID, strategy, err
+	}
+	candidates, err := s.repo.DailyCandidates(ctx, day, s.cfg.RepeatAfter)
+	if err != nil {
+		return "", "", err
+	}
+	if len(candidates) == 0 {
+		return "", "", ErrNoCandidates
+	}
+	quizID, strategy = s.pick(ctx, candidates)
+	return s.repo.SetDailyQuiz(ctx, day, quizID, strategy)
+}
+
+// pick выбирает квиз среди кандидатов настроенным способом. Если очередь кончилась
+// или рейтинги недоступны, квиз выбирается случайно.
+func (s *Scheduler) pick(ctx context.Context, candidates []string) (string, string) {
+	switch s.cfg.Strategy {
+	case StrategyQueue:
+		for _, id := range s.cfg.Queue {
+			if slices.Contains(candidates, id) {
+				return id, StrategyQueue
+			}
+		}
+	case StrategyTopRated:
+		id, err := s.topRated(ctx, candidates)
+		if err != nil {
+			s.l.Error(ctx, "failed to pick top rated daily quiz", zap.Error(err))
+		}
+		if id != "" {
+			return id, StrategyTopRated
+		}
+	}
+	return candidates[rand.IntN(len(candidates))], StrategyRandom
+}
+
+// topRated возвращает кандидата с наибольшей средней оценкой, при равенстве - самого играемого.
+// Рейтинг читается постранично целиком.
+func (s *Scheduler) topRated(ctx context.Context, candidates []string) (string, error) {
+	if s.ratings == nil {
+		return "", nil
+	}
+	req := &statapi.ListQuizzesRequest{Option: statapi.ListQuizzesOption_AVG_RATE, PageSize: ratingsPageSize}
+	var best *statapi.QuizStat
+	for {
+		resp, err := s.ratings.ListQuizzes(ctx, req)
+		if err != nil {
+			return "", err
+		}
+		for _, quiz := range resp.GetQuizzes() {
+			if quiz.GetNumSessions() == 0 || !slices.Contains(candidates, quiz.GetQuizId()) {
+				continue
+			}
+			if best == nil || quiz.GetAvgRate() > best.GetAvgRate() ||
+				quiz.GetAvgRate() == best.GetAvgRate() && quiz.GetNumSessions() > best.GetNumSessions() {
+				best = quiz
+			}
+		}
+		if resp.GetNextPageToken() == "" {
+			break
+		}
+		req.PageToken = resp.GetNextPageToken()
+	}
+	if best == nil {
+		return "", nil
+	}
+	return best.GetQuizId(), nil
+}
+
+// Run выбирает квиз дня при старте и после каждой полуночи по UTC, пока не отменен ctx
+func (s *Scheduler) Run(ctx context.Context) {
+	for {
+		now := time.Now()
+		wait := Today(now).AddDate(0, 0, 1).Sub(now)
+		quizID, strategy, err := s.Quiz(ctx, Today(now))
+		if err != nil {
+			s.l.Error(ctx, "failed to pick daily quiz", zap.Error(err))
+			wait = min(wait, retryDelay)
+		} else {
+			s.l.Info(ctx, "daily quiz picked", zap.String("quiz", quizID), zap.String("strategy", strategy))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package daily
+
+import (
+	"context"
+	"errors"
+	"quizzes/pkg/logger"
+	statapi "quizzes/pkg/statapi/v1"
+	"strconv"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+type fakeRepo struct {
+	picked     map[time.Time]string
+	candidates []string
+}
+
+func (r *fakeRepo) DailyQuiz(ctx context.Context, day time.Time) (string, string, error) {
+	if id, ok := r.picked[day]; ok {
+		return id, "stored", nil
+	}
+	return "", "", nil
+}
+
+func (r *fakeRepo) DailyCandidates(ctx context.Context, day time.Time, repeatAfter int) ([]string, error) {
+	return r.candidates, nil
+}
+
+func (r *fakeRepo) SetDailyQuiz(ctx context.Context, day time.Time, quizID string, strategy string) (string, string, error) {
+	r.picked[day] = quizID
+	return quizID, strategy, nil
+}
+
+// fakeRatings отдает рейтинг страницами по два квиза
+type fakeRatings struct {
+	quizzes []*statapi.QuizStat
+	err     error
+}
+
+func (r *fakeRatings) ListQuizzes(ctx context.Context, req *statapi.ListQuizzesRequest, opts ...grpc.CallOption) (*statapi.ListQuizzesResponse, error) {
+	offset, _ := strconv.Atoi(req.GetPageToken())
+	end := min(offset+2, len(r.quizzes))
+	resp := &statapi.ListQuizzesResponse{Quizzes: r.quizzes[offset:end]}
+	if end < len(r.quizzes) {
+		resp.NextPageToken = strconv.Itoa(end)
+	}
+	return resp, r.err
+}
+
+func newScheduler(t *testing.T, cfg Config, repo Repository, ratings Ratings) *Scheduler {
+	t.Helper()
+	ctx, err := logger.NewLog(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := New(cfg, repo, ratings, logger.GetLoggerFromCtx(ctx))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestToday(t *testing.T) {
+	now := time.Date(2025, 5, 1, 1, 30, 0, 0, time.FixedZone("MSK", 3*60*60))
+	if day := Today(now); !day.Equal(time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("expected the UTC day, got %v", day)
+	}
+}
+
+func TestNewRejectsUnknownStrategy(t *testing.T) {
+	if _, err := New(Config{Strategy: "newest"}, &fakeRepo{}, nil, nil); err == nil {
+		t.Fatal("expected an error for an unknown strategy")
+	}
+}
+
+func TestQueueStrategy(t *testing.T) {
+	repo := &fakeRepo{picked: map[time.Time]string{}, candidates: []string{"a", "b", "c"}}
+	s := newScheduler(t, Config{Strategy: StrategyQueue, Queue: []string{"gone", "c", "a"}}, repo, nil)
+	day := Today(time.Now())
+	id, strategy, err := s.Quiz(context.Background(), day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "c" || strategy != StrategyQueue {
+		t.Fatalf("expected the first playable quiz of the queue, got %q by %q", id, strategy)
+	}
+
+	// Выбранный квиз дня не меняется при повторных обращениях
+	repo.candidates = []string{"a"}
+	if id, strategy, _ = s.Quiz(context.Background(), day); id != "c" || strategy != "stored" {
+		t.Fatalf("expected the stored quiz, got %q by %q", id, strategy)
+	}
+
+	// Когда очередь кончилась, квиз выбирается случайно
+	repo.candidates = []string{"b"}
+	if id, strategy, _ = s.Quiz(context.Background(), day.AddDate(0, 0, 1)); id != "b" || strategy != StrategyRandom {
+		t.Fatalf("expected a random pick after the queue, got %q by %q", id, strategy)
+	}
+}
+
+func TestTopRatedStrategy(t *testing.T) {
+	ratings := &fakeRatings{quizzes: []*statapi.QuizStat{
+		{QuizId: "a", AvgRate: 4, NumSessions: 10},
+		{QuizId: "b", AvgRate: 4.5, NumSessions: 3},
+		{QuizId: "c", AvgRate: 4.5, NumSessions: 7},
+		{QuizId: "d", AvgRate: 5, NumSessions: 2},
+	}}
+	repo := &fakeRepo{picked: map[time.Time]string{}, candidates: []string{"a", "b", "c"}}
+	s := newScheduler(t, Config{Strategy: StrategyTopRated}, repo, ratings)
+	id, strategy, err := s.Quiz(context.Background(), Today(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "c" || strategy != StrategyTopRated {
+		t.Fatalf("expected the best rated candidate, got %q by %q", id, strategy)
+	}
+
+	ratings.err = errors.New("stat_service is down")
+	id, strategy, err = s.Quiz(context.Background(), Today(time.Now()).AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strategy != StrategyRandom || id == "" {
+		t.Fatalf("expected a random pick without ratings, got %q by %q", id, strategy)
+	}
+}
+
+func TestNoCandidates(t *testing.T) {
+	s := newScheduler(t, Config{Strategy: StrategyRandom}, &fakeRepo{picked: map[time.Time]string{}}, nil)
+	if _, _, err := s.Quiz(context.Background(), Today(time.Now())); !errors.Is(err, ErrNoCandidates) {
+		t.Fatalf("expected ErrNoCandidates, got %v", err)
+	}
+}
@@ -0,0 +1,164 @@
+package formats
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	api "quizzes/pkg/api/v1"
+	"strconv"
+	"strings"
+)
+
+// Колонки CSV. Строка с заполненным question начинает новый вопрос,
+// строки с пустым question добавляют ответы к предыдущему.
+var csvColumns = []string{"question", "type", "answer", "correct", "match", "numeric_answer", "numeric_tolerance", "image_id"}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func exportCSV(quiz *api.CreateQuizRequest) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	rows := [][]string{csvColumns}
+	for _, q := range quiz.Question {
+		row := make([]string, len(csvColumns))
+		row[0] = q.QuestionText
+		row[1] = typeNames[q.QuestionType]
+		if q.NumericAnswer != nil {
+			row[5] = formatFloat(*q.NumericAnswer)
+			row[6] = formatFloat(q.NumericTolerance)
+		}
+		if q.ImageId != nil {
+			row[7] = *q.ImageId
+		}
+		if len(q.Answer) == 0 {
+			rows = append(rows, row)
+		}
+		for j, a := range q.Answer {
+			if j > 0 {
+				row = make([]string, len(csvColumns))
+			}
+			row[2] = a.AnswerText
+			row[3] = strconv.FormatBool(a.IsCorrect)
+			row[4] = a.MatchText
+			rows = append(rows, row)
+		}
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return nil, fmt.Errorf("failed to write csv: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func parseBool(s string) (bool, bool) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "false", "0", "no", "-":
+		return false, true
+	case "true", "1", "yes", "+":
+		return true, true
+	}
+	return false, false
+}
+
+func importCSV(content string, res *Result) {
+	r := csv.NewReader(strings.NewReader(content))
+	r.FieldsPerRecord = -1
+	header, err := r.Read()
+	if err != nil {
+		res.errorf(1, "", "failed to read header: %v", err)
+		return
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		// Excel сохраняет UTF-8 с BOM в начале файла
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		known := false
+		for _, c := range csvColumns {
+			known = known || c == name
+		}
+		if !known {
+			res.errorf(1, name, "unknown column, expected some of %s", strings.Join(csvColumns, ", "))
+			continue
+		}
+		columns[name] = i
+	}
+	for _, required := range []string{"question", "answer"} {
+		if _, ok := columns[required]; !ok {
+			res.errorf(1, required, "column is required")
+		}
+	}
+	if len(res.Errors) > 0 {
+		return
+	}
+
+	var current *api.CreateQuestion
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				res.errorf(parseErr.Line, "", "invalid csv: %v", parseErr.Err)
+				return
+			}
+			res.errorf(0, "", "invalid csv: %v", err)
+			return
+		}
+		line, _ := r.FieldPos(0)
+		cell := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+		if strings.Join(record, "") == "" {
+			continue
+		}
+
+		if text := cell("question"); text != "" {
+			questionType, ok := typeFromName(cell("type"))
+			if !ok {
+				res.errorf(line, "type", "unknown question type %q", cell("type"))
+			}
+			current = &api.CreateQuestion{QuestionText: text, QuestionType: questionType}
+			if image := cell("image_id"); image != "" {
+				current.ImageId = &image
+			}
+			if v := cell("numeric_answer"); v != "" {
+				f, err := strconv.ParseFloat(v, 64)
+				if err != nil {
+					res.errorf(line, "numeric_answer", "%q is not a number", v)
+				}
+				current.NumericAnswer = &f
+			}
+			if v := cell("numeric_tolerance"); v != "" {
+				f, err := strconv.ParseFloat(v, 64)
+				if err != nil {
+					res.errorf(line, "numeric_tolerance", "%q is not a number", v)
+				}
+				current.NumericTolerance = f
+			}
+			res.Quiz.Question = append(res.Quiz.Question, current)
+			res.Lines = append(res.Lines, int32(line))
+		} else if current == nil {
+			res.errorf(line, "question", "answer row before the first question")
+			continue
+		}
+
+		answer := cell("answer")
+		if answer == "" {
+			continue
+		}
+		correct, ok := parseBool(cell("correct"))
+		if !ok {
+			res.errorf(line, "correct", "%q is not a boolean, use true or false", cell("correct"))
+		}
+		current.Answer = append(current.Answer, &api.CreateAnswer{AnswerText: answer, IsCorrect: correct, MatchText: cell("match")})
+	}
+}
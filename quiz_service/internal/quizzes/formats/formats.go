@@ -0,0 +1,79 @@
+package formats
+
+import (
+	"fmt"
+	api "quizzes/pkg/api/v1"
+	"strings"
+)
+
+// Result - разобранный файл. Lines хранит строку начала каждого вопроса, 0 если она неизвестна.
+type Result struct {
+	Quiz   *api.CreateQuizRequest
+	Lines  []int32
+	Errors []*api.ImportError
+}
+
+func (r *Result) errorf(line int, field string, format string, args ...any) {
+	r.Errors = append(r.Errors, &api.ImportError{
+		Line:    int32(line),
+		Field:   field,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// Имена типов вопросов в JSON и CSV
+var typeNames = map[api.QuestionType]string{
+	api.QuestionType_QUESTION_TYPE_SINGLE_CHOICE: "single_choice",
+	api.QuestionType_QUESTION_TYPE_MULTI_SELECT:  "multi_select",
+	api.QuestionType_QUESTION_TYPE_TRUE_FALSE:    "true_false",
+	api.QuestionType_QUESTION_TYPE_FREE_TEXT:     "free_text",
+	api.QuestionType_QUESTION_TYPE_NUMERIC:       "numeric",
+	api.QuestionType_QUESTION_TYPE_ORDERING:      "ordering",
+	api.QuestionType_QUESTION_TYPE_MATCHING:      "matching",
+}
+
+// typeFromName понимает пустое имя как single_choice
+func typeFromName(name string) (api.QuestionType, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return api.QuestionType_QUESTION_TYPE_SINGLE_CHOICE, true
+	}
+	for t, n := range typeNames {
+		if n == name {
+			return t, true
+		}
+	}
+	return 0, false
+}
+
+// Export сериализует квиз и возвращает содержимое с его MIME-типом
+func Export(quiz *api.CreateQuizRequest, format api.QuizFormat) ([]byte, string, error) {
+	switch format {
+	case api.QuizFormat_QUIZ_FORMAT_JSON:
+		data, err := exportJSON(quiz)
+		return data, "application/json", err
+	case api.QuizFormat_QUIZ_FORMAT_CSV:
+		data, err := exportCSV(quiz)
+		return data, "text/csv; charset=utf-8", err
+	case api.QuizFormat_QUIZ_FORMAT_GIFT:
+		data, err := exportGIFT(quiz)
+		return data, "text/plain; charset=utf-8", err
+	}
+	return nil, "", fmt.Errorf("unknown format %v", format)
+}
+
+// Import разбирает файл. Ошибки разбора не прерывают его: собираются все, что удалось найти.
+func Import(format api.QuizFormat, content string) (*Result, error) {
+	res := &Result{Quiz: &api.CreateQuizRequest{}}
+	switch format {
+	case api.QuizFormat_QUIZ_FORMAT_JSON:
+		importJSON(content, res)
+	case api.QuizFormat_QUIZ_FORMAT_CSV:
+		importCSV(content, res)
+	case api.QuizFormat_QUIZ_FORMAT_GIFT:
+		importGIFT(content, res)
+	default:
+		return nil, fmt.Errorf("unknown format %v", format)
+	}
+	return res, nil
+}
@@ -0,0 +1,204 @@
+package formats
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	api "quizzes/pkg/api/v1"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func sampleQuiz() *api.CreateQuizRequest {
+	return &api.CreateQuizRequest{
+		Name:        "Столицы",
+		Description: ptr("Проверь себя"),
+		Tags:        []string{"geography"},
+		Timing:      &api.QuizTiming{TimeLimitSeconds: 300, SpeedBonus: true},
+		Shuffle: &api.QuizShuffle{
+			ShuffleQuestions: true,
+			Pools:            []*api.QuestionPool{{Name: "capitals", Draw: 1}},
+		},
+		Question: []*api.CreateQuestion{
+			{
+				QuestionText:     "Столица Франции?",
+				TimeLimitSeconds: 20,
+				Pool:             "capitals",
+				Explanation:      ptr("Париж - столица с 987 года"),
+				Hints:            []*api.Hint{{Text: "Город на Сене", Cost: 0.25}},
+				Answer: []*api.CreateAnswer{
+					{AnswerText: "Париж", IsCorrect: true},
+					{AnswerText: "Лион", Feedback: "Лион - третий по величине город"},
+				},
+			},
+			{
+				QuestionType: api.QuestionType_QUESTION_TYPE_MULTI_SELECT,
+				QuestionText: "Города {Италии}",
+				Answer: []*api.CreateAnswer{
+					{AnswerText: "Рим", IsCorrect: true},
+					{AnswerText: "Милан", IsCorrect: true},
+					{AnswerText: "Вена"},
+				},
+			},
+			{
+				QuestionType: api.QuestionType_QUESTION_TYPE_TRUE_FALSE,
+				QuestionText: "Берлин - столица Германии",
+				Answer: []*api.CreateAnswer{
+					{AnswerText: giftTrue, IsCorrect: true},
+					{AnswerText: giftFalse},
+				},
+			},
+			{
+				QuestionType: api.QuestionType_QUESTION_TYPE_FREE_TEXT,
+				QuestionText: "Столица Японии",
+				Answer: []*api.CreateAnswer{
+					{AnswerText: "Токио", IsCorrect: true},
+				},
+			},
+			{
+				QuestionType:     api.QuestionType_QUESTION_TYPE_NUMERIC,
+				QuestionText:     "Сколько стран в ЕС?",
+				NumericAnswer:    ptr(27.0),
+				NumericTolerance: 0.5,
+			},
+			{
+				QuestionType: api.QuestionType_QUESTION_TYPE_MATCHING,
+				QuestionText: "Страна и столица",
+				Answer: []*api.CreateAnswer{
+					{AnswerText: "Испания", MatchText: "Мадрид"},
+					{AnswerText: "Греция", MatchText: "Афины"},
+				},
+			},
+		},
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, format := range []api.QuizFormat{api.QuizFormat_QUIZ_FORMAT_JSON, api.QuizFormat_QUIZ_FORMAT_CSV, api.QuizFormat_QUIZ_FORMAT_GIFT} {
+		t.Run(format.String(), func(t *testing.T) {
+			quiz := sampleQuiz()
+			data, _, err := Export(quiz, format)
+			if err != nil {
+				t.Fatalf("export failed: %v", err)
+			}
+			res, err := Import(format, string(data))
+			if err != nil {
+				t.Fatalf("import failed: %v", err)
+			}
+			if len(res.Errors) > 0 {
+				t.Fatalf("unexpected errors: %v\n%s", res.Errors, data)
+			}
+			if format != api.QuizFormat_QUIZ_FORMAT_JSON {
+				// CSV и GIFT не хранят описание квиза, ограничения времени, пулы, пояснения и подсказки
+				res.Quiz.Name, res.Quiz.Description, res.Quiz.Tags = quiz.Name, quiz.Description, quiz.Tags
+				res.Quiz.Timing, res.Quiz.Shuffle = quiz.Timing, quiz.Shuffle
+				res.Quiz.Question[0].TimeLimitSeconds = quiz.Question[0].TimeLimitSeconds
+				res.Quiz.Question[0].Pool = quiz.Question[0].Pool
+				res.Quiz.Question[0].Explanation, res.Quiz.Question[0].Hints = quiz.Question[0].Explanation, quiz.Question[0].Hints
+				res.Quiz.Question[0].Answer[1].Feedback = quiz.Question[0].Answer[1].Feedback
+			}
+			if format == api.QuizFormat_QUIZ_FORMAT_GIFT {
+				// В GIFT правильность ответов на свободный ввод подразумевается
+				for _, a := range res.Quiz.Question[3].Answer {
+					a.IsCorrect = true
+				}
+			}
+			if !proto.Equal(quiz, res.Quiz) {
+				t.Fatalf("round trip changed the quiz:\nwant %v\ngot  %v\n%s", quiz, res.Quiz, data)
+			}
+		})
+	}
+}
+
+func TestImportErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  api.QuizFormat
+		content string
+		line    int32
+		field   string
+	}{
+		{
+			name:    "json syntax",
+			format:  api.QuizFormat_QUIZ_FORMAT_JSON,
+			content: "{\n  \"version\": 1,\n  \"name\": \"x\",,\n}",
+			line:    3,
+		},
+		{
+			name:    "json version",
+			format:  api.QuizFormat_QUIZ_FORMAT_JSON,
+			content: `{"version": 2, "questions": []}`,
+			field:   "version",
+		},
+		{
+			name:    "csv correct flag",
+			format:  api.QuizFormat_QUIZ_FORMAT_CSV,
+			content: "question,answer,correct\nQ1,a,true\n,b,maybe\n",
+			line:    3,
+			field:   "correct",
+		},
+		{
+			name:    "csv unknown type",
+			format:  api.QuizFormat_QUIZ_FORMAT_CSV,
+			content: "question,type,answer\nQ1,essay,a\n",
+			line:    2,
+			field:   "type",
+		},
+		{
+			name:    "gift without answers",
+			format:  api.QuizFormat_QUIZ_FORMAT_GIFT,
+			content: "// bank\n\nQ1 {=a ~b}\n\nQ2 without braces\n",
+			line:    5,
+			field:   "question 2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := Import(tt.format, tt.content)
+			if err != nil {
+				t.Fatalf("import failed: %v", err)
+			}
+			if len(res.Errors) != 1 {
+				t.Fatalf("expected one error, got %v", res.Errors)
+			}
+			if got := res.Errors[0]; got.Line != tt.line || got.Field != tt.field {
+				t.Fatalf("expected line %d field %q, got %v", tt.line, tt.field, got)
+			}
+		})
+	}
+}
+
+func TestImportGIFTVariants(t *testing.T) {
+	content := strings.Join([]string{
+		"::T1::[html]Кто написал «Войну и мир»? {",
+		"  =Толстой#верно",
+		"  ~Достоевский",
+		"}",
+		"",
+		"Пи примерно равно {#3.1..3.2}",
+		"",
+		"Земля круглая {TRUE}",
+	}, "\n")
+	res, err := Import(api.QuizFormat_QUIZ_FORMAT_GIFT, content)
+	if err != nil || len(res.Errors) > 0 {
+		t.Fatalf("unexpected errors: %v %v", err, res.Errors)
+	}
+	if got := res.Lines; len(got) != 3 || got[0] != 1 || got[1] != 6 || got[2] != 8 {
+		t.Fatalf("unexpected question lines %v", got)
+	}
+	q := res.Quiz.Question
+	if q[0].QuestionText != "Кто написал «Войну и мир»?" || q[0].Answer[0].AnswerText != "Толстой" || !q[0].Answer[0].IsCorrect {
+		t.Fatalf("unexpected choice question %v", q[0])
+	}
+	if q[1].QuestionType != api.QuestionType_QUESTION_TYPE_NUMERIC || math.Abs(*q[1].NumericAnswer-3.15) > 1e-9 {
+		t.Fatalf("unexpected numeric question %v", q[1])
+	}
+	if q[2].QuestionType != api.QuestionType_QUESTION_TYPE_TRUE_FALSE || !q[2].Answer[0].IsCorrect {
+		t.Fatalf("unexpected true/false question %v", q[2])
+	}
+}
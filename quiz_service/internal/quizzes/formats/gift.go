@@ -0,0 +1,383 @@
+package formats
+
+import (
+	"bytes"
+	"fmt"
+	api "quizzes/pkg/api/v1"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Тексты ответов вопроса "верно/неверно", в GIFT у него нет своих ответов
+const (
+	giftTrue  = "Верно"
+	giftFalse = "Неверно"
+)
+
+// giftSpecial - символы, которые в GIFT экранируются обратной косой чертой
+const giftSpecial = `~=#{}:\`
+
+func giftEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if strings.ContainsRune(giftSpecial, r) {
+			b.WriteByte('\\')
+		}
+		if r == '\n' {
+			b.WriteString(`\n`)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func giftUnescape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+			if s[i] == 'n' {
+				b.WriteByte('\n')
+				continue
+			}
+		}
+		b.WriteByte(s[i])
+	}
+	return strings.TrimSpace(b.String())
+}
+
+func exportGIFT(quiz *api.CreateQuizRequest) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// %s\n", strings.ReplaceAll(quiz.Name, "\n", " "))
+	if quiz.Description != nil && *quiz.Description != "" {
+		fmt.Fprintf(&buf, "// %s\n", strings.ReplaceAll(*quiz.Description, "\n", " "))
+	}
+	for i, q := range quiz.Question {
+		fmt.Fprintf(&buf, "\n::Q%d:: %s {", i+1, giftEscape(q.QuestionText))
+		switch q.QuestionType {
+		case api.QuestionType_QUESTION_TYPE_SINGLE_CHOICE:
+			for _, a := range q.Answer {
+				mark := "~"
+				if a.IsCorrect {
+					mark = "="
+				}
+				fmt.Fprintf(&buf, "\n\t%s%s", mark, giftEscape(a.AnswerText))
+			}
+		case api.QuestionType_QUESTION_TYPE_MULTI_SELECT:
+			correct := 0
+			for _, a := range q.Answer {
+				if a.IsCorrect {
+					correct++
+				}
+			}
+			for _, a := range q.Answer {
+				weight := "-100"
+				if a.IsCorrect {
+					weight = strconv.FormatFloat(100/float64(correct), 'f', 5, 64)
+					weight = strings.TrimRight(strings.TrimRight(weight, "0"), ".")
+				}
+				fmt.Fprintf(&buf, "\n\t~%%%s%%%s", weight, giftEscape(a.AnswerText))
+			}
+		case api.QuestionType_QUESTION_TYPE_TRUE_FALSE:
+			if len(q.Answer) > 0 && q.Answer[0].IsCorrect {
+				buf.WriteString("T")
+			} else {
+				buf.WriteString("F")
+			}
+		case api.QuestionType_QUESTION_TYPE_FREE_TEXT:
+			for _, a := range q.Answer {
+				fmt.Fprintf(&buf, "\n\t=%s", giftEscape(a.AnswerText))
+			}
+		case api.QuestionType_QUESTION_TYPE_NUMERIC:
+			if q.NumericAnswer == nil {
+				return nil, fmt.Errorf("question %d: numeric_answer is missing", i+1)
+			}
+			fmt.Fprintf(&buf, "#%s:%s", formatFloat(*q.NumericAnswer), formatFloat(q.NumericTolerance))
+		case api.QuestionType_QUESTION_TYPE_MATCHING:
+			for _, a := range q.Answer {
+				fmt.Fprintf(&buf, "\n\t=%s -> %s", giftEscape(a.AnswerText), giftEscape(a.MatchText))
+			}
+		default:
+			return nil, fmt.Errorf("question %d: %s questions can not be expressed in GIFT", i+1, typeNames[q.QuestionType])
+		}
+		// Верно/неверно и числовой ответ пишутся в одну строку, списки ответов - по строке на ответ
+		if q.QuestionType == api.QuestionType_QUESTION_TYPE_TRUE_FALSE || q.QuestionType == api.QuestionType_QUESTION_TYPE_NUMERIC {
+			buf.WriteString("}\n")
+		} else {
+			buf.WriteString("\n}\n")
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// indexUnescaped ищет символ, не экранированный обратной косой чертой
+func indexUnescaped(s string, c byte) int {
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case c:
+			return i
+		}
+	}
+	return -1
+}
+
+// giftBlock - один вопрос GIFT и строка, с которой он начинается
+type giftBlock struct {
+	line int
+	text string
+}
+
+// giftBlocks делит файл на вопросы по пустым строкам, пропуская комментарии и $CATEGORY
+func giftBlocks(content string) []giftBlock {
+	var blocks []giftBlock
+	var current []string
+	start := 0
+	flush := func() {
+		if len(current) > 0 {
+			blocks = append(blocks, giftBlock{line: start, text: strings.Join(current, "\n")})
+			current = nil
+		}
+	}
+	for i, line := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, "//"), strings.HasPrefix(trimmed, "$CATEGORY"):
+			continue
+		case trimmed == "":
+			// Пустая строка внутри фигурных скобок не разделяет вопросы
+			joined := strings.Join(current, "\n")
+			if indexUnescaped(joined, '{') >= 0 && indexUnescaped(joined, '}') < 0 {
+				continue
+			}
+			flush()
+			continue
+		}
+		if len(current) == 0 {
+			start = i + 1
+		}
+		current = append(current, line)
+	}
+	flush()
+	return blocks
+}
+
+var giftFormatPrefix = regexp.MustCompile(`^\[(html|moodle|plain|markdown)\]`)
+
+func importGIFT(content string, res *Result) {
+	for _, block := range giftBlocks(content) {
+		field := fmt.Sprintf("question %d", len(res.Quiz.Question)+1)
+		text := block.text
+		open := indexUnescaped(text, '{')
+		if open < 0 {
+			res.errorf(block.line, field, "answer block {...} is missing")
+			continue
+		}
+		closing := indexUnescaped(text[open:], '}')
+		if closing < 0 {
+			res.errorf(block.line, field, "answer block is not closed with }")
+			continue
+		}
+		closing += open
+		prefix, body, suffix := strings.TrimSpace(text[:open]), text[open+1:closing], strings.TrimSpace(text[closing+1:])
+
+		// Заголовок ::title:: не хранится, текстом вопроса остается остальное
+		if strings.HasPrefix(prefix, "::") {
+			if end := strings.Index(prefix[2:], "::"); end >= 0 {
+				prefix = strings.TrimSpace(prefix[end+4:])
+			}
+		}
+		prefix = giftFormatPrefix.ReplaceAllString(prefix, "")
+		questionText := giftUnescape(prefix)
+		if suffix != "" {
+			// Вопрос с пропущенным словом: ответы вставляются на место скобок
+			questionText = questionText + " _____ " + giftUnescape(suffix)
+		}
+
+		q, err := giftQuestion(body)
+		if err != nil {
+			res.errorf(block.line, field, "%v", err)
+			continue
+		}
+		q.QuestionText = questionText
+		res.Quiz.Question = append(res.Quiz.Question, q)
+		res.Lines = append(res.Lines, int32(block.line))
+	}
+}
+
+// giftAnswer - ответ из фигурных скобок: маркер = или ~, вес в процентах и текст без комментария
+type giftAnswer struct {
+	mark   byte
+	weight *float64
+	text   string
+}
+
+func splitGIFTAnswers(body string) ([]giftAnswer, error) {
+	var answers []giftAnswer
+	start := -1
+	push := func(end int) error {
+		if start < 0 {
+			if strings.TrimSpace(body[:end]) != "" {
+				return fmt.Errorf("answer %q has to start with = or ~", strings.TrimSpace(body[:end]))
+			}
+			return nil
+		}
+		a := giftAnswer{mark: body[start]}
+		raw := body[start+1 : end]
+		if feedback := indexUnescaped(raw, '#'); feedback >= 0 {
+			raw = raw[:feedback]
+		}
+		raw = strings.TrimSpace(raw)
+		if strings.HasPrefix(raw, "%") {
+			end := strings.Index(raw[1:], "%")
+			if end < 0 {
+				return fmt.Errorf("answer weight %q is not closed with %%", raw)
+			}
+			w, err := strconv.ParseFloat(raw[1:end+1], 64)
+			if err != nil {
+				return fmt.Errorf("answer weight %q is not a number", raw[1:end+1])
+			}
+			a.weight = &w
+			raw = raw[end+2:]
+		}
+		a.text = raw
+		answers = append(answers, a)
+		return nil
+	}
+	for i := 0; i < len(body); i++ {
+		switch body[i] {
+		case '\\':
+			i++
+		case '=', '~':
+			if err := push(i); err != nil {
+				return nil, err
+			}
+			start = i
+		}
+	}
+	if err := push(len(body)); err != nil {
+		return nil, err
+	}
+	return answers, nil
+}
+
+// giftNumber разбирает "значение:погрешность", "минимум..максимум" или просто число
+func giftNumber(s string) (float64, float64, error) {
+	s = strings.TrimSpace(s)
+	if lo, hi, ok := strings.Cut(s, ".."); ok {
+		min, err1 := strconv.ParseFloat(strings.TrimSpace(lo), 64)
+		max, err2 := strconv.ParseFloat(strings.TrimSpace(hi), 64)
+		if err1 != nil || err2 != nil {
+			return 0, 0, fmt.Errorf("invalid numeric range %q", s)
+		}
+		return (min + max) / 2, (max - min) / 2, nil
+	}
+	value, tolerance, _ := strings.Cut(s, ":")
+	v, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid number %q", value)
+	}
+	var t float64
+	if tolerance != "" {
+		if t, err = strconv.ParseFloat(strings.TrimSpace(tolerance), 64); err != nil {
+			return 0, 0, fmt.Errorf("invalid tolerance %q", tolerance)
+		}
+	}
+	return v, t, nil
+}
+
+func giftQuestion(body string) (*api.CreateQuestion, error) {
+	trimmed := strings.TrimSpace(body)
+	if feedback := indexUnescaped(trimmed, '#'); feedback > 0 {
+		switch strings.ToUpper(strings.TrimSpace(trimmed[:feedback])) {
+		case "T", "TRUE", "F", "FALSE":
+			trimmed = strings.TrimSpace(trimmed[:feedback])
+		}
+	}
+	switch strings.ToUpper(trimmed) {
+	case "":
+		return nil, fmt.Errorf("essay questions are not supported")
+	case "T", "TRUE", "F", "FALSE":
+		isTrue := strings.HasPrefix(strings.ToUpper(trimmed), "T")
+		return &api.CreateQuestion{
+			QuestionType: api.QuestionType_QUESTION_TYPE_TRUE_FALSE,
+			Answer: []*api.CreateAnswer{
+				{AnswerText: giftTrue, IsCorrect: isTrue},
+				{AnswerText: giftFalse, IsCorrect: !isTrue},
+			},
+		}, nil
+	}
+	if strings.HasPrefix(trimmed, "#") {
+		// Из нескольких вариантов числового ответа берется первый
+		value := trimmed[1:]
+		if answers, err := splitGIFTAnswers(value); err == nil && len(answers) > 0 {
+			value = answers[0].text
+		}
+		v, t, err := giftNumber(value)
+		if err != nil {
+			return nil, err
+		}
+		return &api.CreateQuestion{
+			QuestionType:     api.QuestionType_QUESTION_TYPE_NUMERIC,
+			NumericAnswer:    &v,
+			NumericTolerance: t,
+		}, nil
+	}
+
+	answers, err := splitGIFTAnswers(body)
+	if err != nil {
+		return nil, err
+	}
+	q := &api.CreateQuestion{}
+	matching, wrong, weighted := false, false, false
+	for _, a := range answers {
+		matching = matching || indexArrow(a.text) >= 0
+		wrong = wrong || a.mark == '~'
+		weighted = weighted || (a.mark == '~' && a.weight != nil)
+	}
+	switch {
+	case matching:
+		q.QuestionType = api.QuestionType_QUESTION_TYPE_MATCHING
+	case !wrong:
+		q.QuestionType = api.QuestionType_QUESTION_TYPE_FREE_TEXT
+	case weighted:
+		q.QuestionType = api.QuestionType_QUESTION_TYPE_MULTI_SELECT
+	default:
+		q.QuestionType = api.QuestionType_QUESTION_TYPE_SINGLE_CHOICE
+	}
+	for _, a := range answers {
+		answer := &api.CreateAnswer{}
+		switch q.QuestionType {
+		case api.QuestionType_QUESTION_TYPE_MATCHING:
+			arrow := indexArrow(a.text)
+			if a.mark != '=' || arrow < 0 {
+				return nil, fmt.Errorf("matching answer %q has to look like =left -> right", a.text)
+			}
+			answer.AnswerText, answer.MatchText = giftUnescape(a.text[:arrow]), giftUnescape(a.text[arrow+2:])
+		case api.QuestionType_QUESTION_TYPE_MULTI_SELECT:
+			answer.AnswerText = giftUnescape(a.text)
+			answer.IsCorrect = a.mark == '=' || (a.weight != nil && *a.weight > 0)
+		default:
+			answer.AnswerText = giftUnescape(a.text)
+			answer.IsCorrect = a.mark == '='
+		}
+		q.Answer = append(q.Answer, answer)
+	}
+	return q, nil
+}
+
+// indexArrow ищет неэкранированную стрелку -> в ответе на сопоставление
+func indexArrow(s string) int {
+	for i := 0; i+1 < len(s); i++ {
+		switch {
+		case s[i] == '\\':
+			i++
+		case s[i] == '-' && s[i+1] == '>':
+			return i
+		}
+	}
+	return -1
+}
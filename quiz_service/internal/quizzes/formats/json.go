@@ -0,0 +1,169 @@
+package formats
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	api "quizzes/pkg/api/v1"
+	"strings"
+)
+
+// jsonVersion - версия схемы. Несовместимые изменения схемы должны ее увеличивать.
+const jsonVersion = 1
+
+type jsonQuiz struct {
+	Version          int            `json:"version"`
+	Name             string         `json:"name"`
+	Description      *string        `json:"description,omitempty"`
+	ImageID          *string        `json:"image_id,omitempty"`
+	Tags             []string       `json:"tags,omitempty"`
+	CategoryID       string         `json:"category_id,omitempty"`
+	TimeLimit        int32          `json:"time_limit_seconds,omitempty"`
+	SpeedBonus       bool           `json:"speed_bonus,omitempty"`
+	ShuffleQuestions bool           `json:"shuffle_questions,omitempty"`
+	ShuffleAnswers   bool           `json:"shuffle_answers,omitempty"`
+	Pools            []jsonPool     `json:"pools,omitempty"`
+	Questions        []jsonQuestion `json:"questions"`
+}
+
+type jsonQuestion struct {
+	Text             string       `json:"text"`
+	Type             string       `json:"type"`
+	ImageID          *string      `json:"image_id,omitempty"`
+	NumericAnswer    *float64     `json:"numeric_answer,omitempty"`
+	NumericTolerance float64      `json:"numeric_tolerance,omitempty"`
+	TimeLimit        int32        `json:"time_limit_seconds,omitempty"`
+	Pool             string       `json:"pool,omitempty"`
+	Explanation      *string      `json:"explanation,omitempty"`
+	Hints            []jsonHint   `json:"hints,omitempty"`
+	Answers          []jsonAnswer `json:"answers,omitempty"`
+}
+
+type jsonPool struct {
+	Name string `json:"name"`
+	Draw int32  `json:"draw"`
+}
+
+type jsonHint struct {
+	Text string  `json:"text"`
+	Cost float32 `json:"cost,omitempty"`
+}
+
+type jsonAnswer struct {
+	Text     string `json:"text"`
+	Correct  bool   `json:"correct,omitempty"`
+	Match    string `json:"match,omitempty"`
+	Feedback string `json:"feedback,omitempty"`
+}
+
+func exportJSON(quiz *api.CreateQuizRequest) ([]byte, error) {
+	out := jsonQuiz{
+		Version:          jsonVersion,
+		Name:             quiz.Name,
+		Description:      quiz.Description,
+		ImageID:          quiz.ImageId,
+		Tags:             quiz.Tags,
+		CategoryID:       quiz.CategoryId,
+		TimeLimit:        quiz.Timing.GetTimeLimitSeconds(),
+		SpeedBonus:       quiz.Timing.GetSpeedBonus(),
+		ShuffleQuestions: quiz.Shuffle.GetShuffleQuestions(),
+		ShuffleAnswers:   quiz.Shuffle.GetShuffleAnswers(),
+		Questions:        make([]jsonQuestion, 0, len(quiz.Question)),
+	}
+	for _, p := range quiz.Shuffle.GetPools() {
+		out.Pools = append(out.Pools, jsonPool{Name: p.Name, Draw: p.Draw})
+	}
+	for _, q := range quiz.Question {
+		question := jsonQuestion{
+			Text:             q.QuestionText,
+			Type:             typeNames[q.QuestionType],
+			ImageID:          q.ImageId,
+			NumericAnswer:    q.NumericAnswer,
+			NumericTolerance: q.NumericTolerance,
+			TimeLimit:        q.TimeLimitSeconds,
+			Pool:             q.Pool,
+			Explanation:      q.Explanation,
+		}
+		for _, h := range q.Hints {
+			question.Hints = append(question.Hints, jsonHint{Text: h.Text, Cost: h.Cost})
+		}
+		for _, a := range q.Answer {
+			question.Answers = append(question.Answers, jsonAnswer{Text: a.AnswerText, Correct: a.IsCorrect, Match: a.MatchText, Feedback: a.Feedback})
+		}
+		out.Questions = append(out.Questions, question)
+	}
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode quiz: %w", err)
+	}
+	return append(data, '\n'), nil
+}
+
+// lineAt возвращает номер строки для смещения в байтах
+func lineAt(content string, offset int64) int {
+	if offset > int64(len(content)) {
+		offset = int64(len(content))
+	}
+	return strings.Count(content[:offset], "\n") + 1
+}
+
+func importJSON(content string, res *Result) {
+	var in jsonQuiz
+	dec := json.NewDecoder(strings.NewReader(content))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&in); err != nil {
+		var syntaxErr *json.SyntaxError
+		var typeErr *json.UnmarshalTypeError
+		switch {
+		case errors.As(err, &syntaxErr):
+			res.errorf(lineAt(content, syntaxErr.Offset), "", "invalid JSON: %v", err)
+		case errors.As(err, &typeErr):
+			res.errorf(lineAt(content, typeErr.Offset), typeErr.Field, "expected %s, got %s", typeErr.Type, typeErr.Value)
+		default:
+			res.errorf(lineAt(content, dec.InputOffset()), "", "invalid JSON: %v", err)
+		}
+		return
+	}
+	if dec.More() {
+		res.errorf(lineAt(content, dec.InputOffset()), "", "unexpected data after the quiz")
+	}
+	if in.Version != jsonVersion {
+		res.errorf(0, "version", "unsupported version %d, expected %d", in.Version, jsonVersion)
+		return
+	}
+
+	res.Quiz.Name = in.Name
+	res.Quiz.Description = in.Description
+	res.Quiz.ImageId = in.ImageID
+	res.Quiz.Tags = in.Tags
+	res.Quiz.CategoryId = in.CategoryID
+	res.Quiz.Timing = &api.QuizTiming{TimeLimitSeconds: in.TimeLimit, SpeedBonus: in.SpeedBonus}
+	res.Quiz.Shuffle = &api.QuizShuffle{ShuffleQuestions: in.ShuffleQuestions, ShuffleAnswers: in.ShuffleAnswers}
+	for _, p := range in.Pools {
+		res.Quiz.Shuffle.Pools = append(res.Quiz.Shuffle.Pools, &api.QuestionPool{Name: p.Name, Draw: p.Draw})
+	}
+	for i, q := range in.Questions {
+		questionType, ok := typeFromName(q.Type)
+		if !ok {
+			res.errorf(0, fmt.Sprintf("questions[%d].type", i), "unknown question type %q", q.Type)
+		}
+		question := &api.CreateQuestion{
+			QuestionText:     q.Text,
+			ImageId:          q.ImageID,
+			QuestionType:     questionType,
+			NumericAnswer:    q.NumericAnswer,
+			NumericTolerance: q.NumericTolerance,
+			TimeLimitSeconds: q.TimeLimit,
+			Pool:             q.Pool,
+			Explanation:      q.Explanation,
+		}
+		for _, h := range q.Hints {
+			question.Hints = append(question.Hints, &api.Hint{Text: h.Text, Cost: h.Cost})
+		}
+		for _, a := range q.Answers {
+			question.Answer = append(question.Answer, &api.CreateAnswer{AnswerText: a.Text, IsCorrect: a.Correct, MatchText: a.Match, Feedback: a.Feedback})
+		}
+		res.Quiz.Question = append(res.Quiz.Question, question)
+		res.Lines = append(res.Lines, 0)
+	}
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"quizzes/internal/quizzes/daily"
+	api "quizzes/pkg/api/v1"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// dailyQuiz возвращает сегодняшний квиз дня
+func (s *QuizService) dailyQuiz(ctx context.Context, day time.Time) (string, string, error) {
+	if s.daily == nil {
+		return "", "", status.Error(codes.Unavailable, "quiz of the day is not available")
+	}
+	quizID, strategy, err := s.daily.Quiz(ctx, day)
+	if errors.Is(err, daily.ErrNoCandidates) {
+		return "", "", status.Error(codes.NotFound, "no quiz of the day")
+	}
+	return quizID, strategy, err
+}
+
+func (s *QuizService) GetDailyQuiz(ctx context.Context, req *api.GetDailyQuizRequest) (*api.GetDailyQuizResponse, error) {
+	day := daily.Today(time.Now())
+	quizID, strategy, err := s.dailyQuiz(ctx, day)
+	if err != nil {
+		return nil, err
+	}
+	quiz, err := s.repo.QuizSummary(ctx, quizID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &api.GetDailyQuizResponse{Date: day.Format(time.DateOnly), Quiz: quiz, Strategy: strategy}
+	// Авторизованный игрок видит, играл ли он сегодня
+	if player, err := s.repo.CurrentUser(ctx); err == nil && player != "" {
+		resp.SessionId, resp.Finished, err = s.repo.DailySession(ctx, day, player)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (s *QuizService) StartDailySession(ctx context.Context, req *api.StartDailySessionRequest) (*api.StartSessionResponse, error) {
+	// Попытка засчитывается в серию дней, поэтому играть квиз дня можно только авторизованным
+	player, err := s.repo.CurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	day := daily.Today(time.Now())
+	quizID, _, err := s.dailyQuiz(ctx, day)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.repo.StartDailySession(ctx, quizID, player, day)
+	if err != nil {
+		return nil, err
+	}
+	hideAnswers(resp.Quiz, resp.Seed)
+	return resp, nil
+}
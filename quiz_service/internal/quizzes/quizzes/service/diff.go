@@ -0,0 +1,80 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	api "quizzes/pkg/api/v1"
+)
+
+// diffFields сравнивает общие поля двух ревизий квиза
+func diffFields(from, to *api.GetQuizResponse) []*api.FieldDiff {
+	var diffs []*api.FieldDiff
+	add := func(field, a, b string) {
+		if a != b {
+			diffs = append(diffs, &api.FieldDiff{Field: field, From: a, To: b})
+		}
+	}
+	add("name", from.Name, to.Name)
+	add("image_id", from.GetImageId(), to.GetImageId())
+	add("description", from.GetDescription(), to.GetDescription())
+	add("time_limit_seconds", strconv.Itoa(int(from.Timing.GetTimeLimitSeconds())), strconv.Itoa(int(to.Timing.GetTimeLimitSeconds())))
+	add("speed_bonus", strconv.FormatBool(from.Timing.GetSpeedBonus()), strconv.FormatBool(to.Timing.GetSpeedBonus()))
+	add("shuffle_questions", strconv.FormatBool(from.Shuffle.GetShuffleQuestions()), strconv.FormatBool(to.Shuffle.GetShuffleQuestions()))
+	add("shuffle_answers", strconv.FormatBool(from.Shuffle.GetShuffleAnswers()), strconv.FormatBool(to.Shuffle.GetShuffleAnswers()))
+	add("pools", formatPools(from.Shuffle.GetPools()), formatPools(to.Shuffle.GetPools()))
+	return diffs
+}
+
+// diffQuestions сравнивает вопросы двух ревизий по их позиции в квизе
+func diffQuestions(from, to []*api.CreateQuestion) []*api.QuestionDiff {
+	var diffs []*api.QuestionDiff
+	for i := 0; i < len(from) || i < len(to); i++ {
+		d := &api.QuestionDiff{Position: int32(i)}
+		switch {
+		case i >= len(to):
+			d.Change, d.From = api.RevisionChange_REVISION_CHANGE_REMOVED, from[i]
+		case i >= len(from):
+			d.Change, d.To = api.RevisionChange_REVISION_CHANGE_ADDED, to[i]
+		case !sameQuestion(from[i], to[i]):
+			d.Change, d.From, d.To = api.RevisionChange_REVISION_CHANGE_MODIFIED, from[i], to[i]
+		default:
+			continue
+		}
+		diffs = append(diffs, d)
+	}
+	return diffs
+}
+
+// sameQuestion сравнивает содержимое вопросов без учета идентификаторов,
+// которые у каждой ревизии свои
+func sameQuestion(a, b *api.CreateQuestion) bool {
+	if a.QuestionText != b.QuestionText || a.GetImageId() != b.GetImageId() || a.QuestionType != b.QuestionType ||
+		a.NumericAnswer == nil != (b.NumericAnswer == nil) || a.GetNumericAnswer() != b.GetNumericAnswer() ||
+		a.NumericTolerance != b.NumericTolerance || a.TimeLimitSeconds != b.TimeLimitSeconds || a.Pool != b.Pool ||
+		a.GetExplanation() != b.GetExplanation() || len(a.Hints) != len(b.Hints) || len(a.Answer) != len(b.Answer) {
+		return false
+	}
+	for i := range a.Answer {
+		x, y := a.Answer[i], b.Answer[i]
+		if x.AnswerText != y.AnswerText || x.IsCorrect != y.IsCorrect || x.MatchText != y.MatchText || x.Feedback != y.Feedback {
+			return false
+		}
+	}
+	for i := range a.Hints {
+		if a.Hints[i].Text != b.Hints[i].Text || a.Hints[i].Cost != b.Hints[i].Cost {
+			return false
+		}
+	}
+	return true
+}
+
+// formatPools записывает пулы одной строкой вида "easy: 2, hard: 1"
+func formatPools(pools []*api.QuestionPool) string {
+	parts := make([]string, len(pools))
+	for i, p := range pools {
+		parts[i] = fmt.Sprintf("%s: %d", p.Name, p.Draw)
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"errors"
+	api "quizzes/pkg/api/v1"
+	"quizzes/pkg/media"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// thumbnailKey - ключ превью картинки в хранилище
+func thumbnailKey(imageID string) string {
+	return imageID + ".thumb"
+}
+
+// UploadImage принимает картинку от авторизованного пользователя, тип определяется по содержимому
+func (s *QuizService) UploadImage(ctx context.Context, req *api.UploadImageRequest) (*api.UploadImageResponse, error) {
+	user, err := s.repo.CurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	img, err := media.Process(req.Data, s.maxImageSize)
+	if errors.Is(err, media.ErrTooLarge) || errors.Is(err, media.ErrUnsupported) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	imageID := uuid.New().String()
+	if err = s.images.Put(ctx, imageID, req.Data); err != nil {
+		return nil, err
+	}
+	if err = s.images.Put(ctx, thumbnailKey(imageID), img.Thumbnail); err != nil {
+		return nil, err
+	}
+	if err = s.repo.SaveImage(ctx, imageID, user, len(req.Data), img); err != nil {
+		return nil, err
+	}
+	return &api.UploadImageResponse{
+		ImageId:     imageID,
+		ContentType: img.ContentType,
+		Size:        int64(len(req.Data)),
+		Width:       int32(img.Width),
+		Height:      int32(img.Height),
+	}, nil
+}
+func (s *QuizService) GetImage(ctx context.Context, req *api.GetImageRequest) (*httpbody.HttpBody, error) {
+	contentType, err := s.repo.GetImageType(ctx, req.ImageId, req.Thumbnail)
+	if err != nil {
+		return nil, err
+	}
+	key := req.ImageId
+	if req.Thumbnail {
+		key = thumbnailKey(req.ImageId)
+	}
+	data, err := s.images.Get(ctx, key)
+	if errors.Is(err, media.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "image not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &httpbody.HttpBody{ContentType: contentType, Data: data}, nil
+}
@@ -0,0 +1,652 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"quizzes/internal/quizzes/repository"
+	api "quizzes/pkg/api/v1"
+	"quizzes/pkg/logger"
+	"quizzes/pkg/rooms"
+	statapi "quizzes/pkg/statapi/v1"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Ограничения комнат и начисление очков
+const (
+	maxNickname    = 24
+	maxRoomPlayers = 500
+	maxRoomTeams   = 10
+	maxRoomPoints  = 1000
+	// roomAnswerTime - за сколько падают очки в вопросе без ограничения времени
+	roomAnswerTime = 20 * time.Second
+	roomLateGrace  = 2 * time.Second
+	// Сколько ждать stat_service при отправке итогов команд
+	teamStatsTimeout = 5 * time.Second
+)
+
+// TeamStats принимает итоги командных игр в stat_service
+type TeamStats interface {
+	RecordTeamResults(context.Context, *statapi.RecordTeamResultsRequest, ...grpc.CallOption) (*statapi.RecordTeamResultsResponse, error)
+}
+
+// RoomService ведет живые комнаты: хост показывает вопросы, игроки отвечают,
+// после каждого вопроса все видят таблицу лидеров
+type RoomService struct {
+	api.RoomServiceServer
+	repo  Repository
+	store rooms.Store
+	stats TeamStats
+	l     *logger.Logger
+}
+
+func NewRooms(repo Repository, store rooms.Store, stats TeamStats, l *logger.Logger) *RoomService {
+	return &RoomService{repo: repo, store: store, stats: stats, l: l}
+}
+
+// participant - одно открытое соединение Play, у хоста нет ника
+type participant struct {
+	roomID   string
+	opened   time.Time
+	nickname string
+	team     string
+	host     bool
+	quiz     *api.GetQuizResponse
+}
+
+// check отсекает команды к комнате, которую закрыли и открыли заново под тем же ID
+func (p *participant) check(room *rooms.Room) error {
+	if !room.Opened.Equal(p.opened) {
+		return status.Error(codes.NotFound, "the room was closed")
+	}
+	return nil
+}
+
+func (s *RoomService) Play(stream api.RoomService_PlayServer) error {
+	ctx := stream.Context()
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	var p *participant
+	var room *rooms.Room
+	var events <-chan []byte
+	var unsubscribe func()
+	switch cmd := first.Command.(type) {
+	case *api.RoomCommand_Create:
+		if p, room, err = s.createRoom(ctx, cmd.Create); err != nil {
+			return err
+		}
+		if events, unsubscribe, err = s.store.Subscribe(ctx, p.roomID); err != nil {
+			s.closeRoom(ctx, p, "the host left")
+			return status.Error(codes.Unavailable, err.Error())
+		}
+	case *api.RoomCommand_Join:
+		if cmd.Join.RoomId == "" {
+			return status.Error(codes.InvalidArgument, "room_id is required")
+		}
+		// Подписка до входа, чтобы не пропустить события, вызванные самим входом
+		if events, unsubscribe, err = s.store.Subscribe(ctx, cmd.Join.RoomId); err != nil {
+			return status.Error(codes.Unavailable, err.Error())
+		}
+		if p, room, err = s.joinRoom(ctx, cmd.Join); err != nil {
+			unsubscribe()
+			return err
+		}
+	default:
+		return status.Error(codes.InvalidArgument, "the first command must be create or join")
+	}
+	defer unsubscribe()
+	// Выход обрабатывается и после обрыва соединения, когда контекст стрима уже отменен
+	defer s.leave(context.WithoutCancel(ctx), p)
+
+	if err = stream.Send(joinedEvent(p)); err != nil {
+		return err
+	}
+	if room.Phase == rooms.PhaseQuestion {
+		if err = stream.Send(questionEvent(p.quiz, room)); err != nil {
+			return err
+		}
+	}
+
+	commands := make(chan *api.RoomCommand)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			cmd, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case commands <- cmd:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	for {
+		select {
+		case data, ok := <-events:
+			if !ok {
+				return status.Error(codes.Unavailable, "lost connection to the room")
+			}
+			var event api.RoomEvent
+			if err = proto.Unmarshal(data, &event); err != nil {
+				return status.Error(codes.Internal, "failed to decode room event")
+			}
+			if err = stream.Send(&event); err != nil {
+				return err
+			}
+			if event.GetClosed() != nil {
+				return nil
+			}
+		case cmd := <-commands:
+			reply, err := s.handle(ctx, p, cmd)
+			if err != nil {
+				reply = errorEvent(err)
+			}
+			if reply != nil {
+				if err = stream.Send(reply); err != nil {
+					return err
+				}
+			}
+		case err = <-recvErr:
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// createRoom открывает комнату по опубликованной ревизии квиза. Хост может переоткрыть свою
+// комнату, например после обрыва соединения, тогда старая закрывается для всех.
+func (s *RoomService) createRoom(ctx context.Context, cmd *api.CreateRoomCommand) (*participant, *rooms.Room, error) {
+	if cmd.QuizId == "" {
+		return nil, nil, status.Error(codes.InvalidArgument, "quiz_id is required")
+	}
+	user, err := s.repo.CurrentUser(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	quiz, err := s.repo.GetVisibleQuiz(ctx, cmd.QuizId, 0, user)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Как и в StartSession, играют только опубликованную ревизию, даже если хост - автор с черновиком
+	if quiz.Status != api.QuizStatus_QUIZ_STATUS_PUBLISHED {
+		return nil, nil, status.Error(codes.FailedPrecondition, "only a published quiz can be played in a room")
+	}
+	if quiz.Revision != quiz.PublishedRevision {
+		quiz, err = s.repo.GetQuiz(ctx, cmd.QuizId, quiz.PublishedRevision)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if len(quiz.Question) == 0 {
+		return nil, nil, status.Error(codes.FailedPrecondition, "quiz has no questions")
+	}
+	teams, err := roomTeams(cmd.Teams)
+	if err != nil {
+		return nil, nil, err
+	}
+	room := &rooms.Room{
+		ID:          quiz.ShortID,
+		QuizID:      quiz.ShortID,
+		Revision:    quiz.Revision,
+		Host:        user,
+		Opened:      time.Now(),
+		Phase:       rooms.PhaseLobby,
+		Teams:       teams,
+		TeamScoring: rooms.ScoringAverage,
+	}
+	if cmd.TeamScoring == api.RoomTeamScoring_ROOM_TEAM_SCORING_SUM {
+		room.TeamScoring = rooms.ScoringSum
+	}
+	err = s.store.Create(ctx, room)
+	if errors.Is(err, rooms.ErrExists) {
+		old, getErr := s.store.Get(ctx, room.ID)
+		if getErr != nil || old.Host != user {
+			return nil, nil, status.Errorf(codes.AlreadyExists, "room %s is already open", room.ID)
+		}
+		s.closeRoom(ctx, &participant{roomID: old.ID, opened: old.Opened, host: true}, "the host reopened the room")
+		err = s.store.Create(ctx, room)
+	}
+	if err != nil {
+		return nil, nil, roomError(err)
+	}
+	return &participant{roomID: room.ID, opened: room.Opened, host: true, quiz: quiz}, room, nil
+}
+
+// roomTeams проверяет названия команд: их либо нет, либо от двух до maxRoomTeams
+func roomTeams(names []string) ([]string, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	if len(names) < 2 || len(names) > maxRoomTeams {
+		return nil, status.Errorf(codes.InvalidArgument, "a team game needs from 2 to %d teams", maxRoomTeams)
+	}
+	teams := make([]string, 0, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" || utf8.RuneCountInString(name) > maxNickname {
+			return nil, status.Errorf(codes.InvalidArgument, "team name must be from 1 to %d characters", maxNickname)
+		}
+		if slices.Contains(teams, name) {
+			return nil, status.Errorf(codes.InvalidArgument, "team %q is listed twice", name)
+		}
+		teams = append(teams, name)
+	}
+	return teams, nil
+}
+
+// joinRoom добавляет игрока. Ник свободен, пока его владелец подключен:
+// вернувшийся после обрыва игрок сохраняет свои очки и команду.
+// Без явной команды игрок попадает в самую маленькую.
+func (s *RoomService) joinRoom(ctx context.Context, cmd *api.JoinRoomCommand) (*participant, *rooms.Room, error) {
+	nickname := strings.TrimSpace(cmd.Nickname)
+	if nickname == "" || utf8.RuneCountInString(nickname) > maxNickname {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "nickname must be from 1 to %d characters", maxNickname)
+	}
+	team := strings.TrimSpace(cmd.Team)
+	room, err := s.store.Update(ctx, cmd.RoomId, func(room *rooms.Room) error {
+		if player := room.Player(nickname); player != nil {
+			if player.Connected {
+				return status.Errorf(codes.AlreadyExists, "nickname %q is taken", nickname)
+			}
+			player.Connected = true
+			team = player.Team
+			return nil
+		}
+		if len(room.Players) >= maxRoomPlayers {
+			return status.Error(codes.ResourceExhausted, "room is full")
+		}
+		switch {
+		case team == "":
+			team = room.SmallestTeam()
+		case len(room.Teams) == 0:
+			return status.Error(codes.InvalidArgument, "the room has no teams")
+		case !slices.Contains(room.Teams, team):
+			return status.Errorf(codes.NotFound, "team %q is not in the room", team)
+		}
+		room.Players = append(room.Players, rooms.Player{Nickname: nickname, Answered: -1, Connected: true, Team: team})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, roomError(err)
+	}
+	p := &participant{roomID: room.ID, opened: room.Opened, nickname: nickname, team: team}
+	if p.quiz, err = s.repo.GetQuiz(ctx, room.QuizID, room.Revision); err != nil {
+		s.leave(ctx, p)
+		return nil, nil, err
+	}
+	if err = s.publish(ctx, room.ID, playersEvent(room)); err != nil {
+		return nil, nil, err
+	}
+	return p, room, nil
+}
+
+// handle выполняет команду участника и возвращает событие только для него
+func (s *RoomService) handle(ctx context.Context, p *participant, cmd *api.RoomCommand) (*api.RoomEvent, error) {
+	switch cmd := cmd.Command.(type) {
+	case *api.RoomCommand_Next:
+		if !p.host {
+			return nil, status.Error(codes.PermissionDenied, "only the host can move the game on")
+		}
+		return nil, s.next(ctx, p)
+	case *api.RoomCommand_Close:
+		if !p.host {
+			return nil, status.Error(codes.PermissionDenied, "only the host can close the room")
+		}
+		return nil, s.closeRoom(ctx, p, "closed by the host")
+	case *api.RoomCommand_Answer:
+		if p.host {
+			return nil, status.Error(codes.FailedPrecondition, "the host does not answer")
+		}
+		return s.answer(ctx, p, cmd.Answer)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "already in the room")
+	}
+}
+
+// next начинает игру, закрывает текущий вопрос или показывает следующий.
+// После итогов последнего вопроса комната закрывается.
+func (s *RoomService) next(ctx context.Context, p *participant) error {
+	last := len(p.quiz.Question) - 1
+	var over bool
+	room, err := s.store.Update(ctx, p.roomID, func(room *rooms.Room) error {
+		if err := p.check(room); err != nil {
+			return err
+		}
+		switch room.Phase {
+		case rooms.PhaseLobby:
+			if len(room.Players) == 0 {
+				return status.Error(codes.FailedPrecondition, "nobody has joined yet")
+			}
+			startQuestion(room, 0)
+		case rooms.PhaseQuestion:
+			room.Phase = rooms.PhaseResults
+		case rooms.PhaseResults:
+			over = room.Question >= last
+			if !over {
+				startQuestion(room, room.Question+1)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return roomError(err)
+	}
+	if over {
+		err = s.closeRoom(ctx, p, "game over")
+		s.recordTeams(ctx, room)
+		return err
+	}
+	if room.Phase == rooms.PhaseQuestion {
+		return s.publish(ctx, room.ID, questionEvent(p.quiz, room))
+	}
+	return s.publish(ctx, room.ID, resultsEvent(p.quiz, room))
+}
+
+func startQuestion(room *rooms.Room, index int) {
+	room.Phase = rooms.PhaseQuestion
+	room.Question = index
+	room.QuestionStarted = time.Now()
+	for i := range room.Players {
+		room.Players[i].LastPoints = 0
+	}
+}
+
+// answer оценивает ответ игрока. Вопрос закрывается сам, когда ответили
+// все подключенные игроки.
+func (s *RoomService) answer(ctx context.Context, p *participant, cmd *api.RoomAnswerCommand) (*api.RoomEvent, error) {
+	room, err := s.store.Update(ctx, p.roomID, func(room *rooms.Room) error {
+		if err := p.check(room); err != nil {
+			return err
+		}
+		if room.Phase != rooms.PhaseQuestion {
+			return status.Error(codes.FailedPrecondition, "no question is open")
+		}
+		question := p.quiz.Question[room.Question]
+		if question.QuestionId != cmd.QuestionId {
+			return status.Errorf(codes.FailedPrecondition, "question %s is not open", cmd.QuestionId)
+		}
+		player := room.Player(p.nickname)
+		if player == nil {
+			return status.Error(codes.NotFound, "you are not in the room")
+		}
+		if player.Answered == room.Question {
+			return status.Error(codes.AlreadyExists, "question is already answered")
+		}
+		elapsed := time.Since(room.QuestionStarted)
+		limit := time.Duration(question.TimeLimitSeconds) * time.Second
+		if limit > 0 && elapsed > limit+roomLateGrace {
+			return status.Error(codes.FailedPrecondition, "time is up")
+		}
+		credit := repository.Grade(question, &api.SubmitAnswerRequest{
+			QuestionId: cmd.QuestionId,
+			AnswerId:   cmd.AnswerId,
+			Text:       cmd.Text,
+			Matches:    cmd.Matches,
+		})
+		points := roomPoints(credit, elapsed, limit)
+		player.Answered = room.Question
+		player.LastPoints = points
+		player.Score += points
+		if credit == 1 {
+			player.Correct++
+		}
+		if answered, connected := roomProgress(room); answered == connected {
+			room.Phase = rooms.PhaseResults
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, roomError(err)
+	}
+	answered, connected := roomProgress(room)
+	progress := &api.RoomEvent{Event: &api.RoomEvent_Progress{Progress: &api.RoomProgressEvent{
+		Answered: int32(answered),
+		Players:  int32(connected),
+	}}}
+	if err = s.publish(ctx, room.ID, progress); err != nil {
+		return nil, err
+	}
+	if room.Phase == rooms.PhaseResults {
+		if err = s.publish(ctx, room.ID, resultsEvent(p.quiz, room)); err != nil {
+			return nil, err
+		}
+	}
+	return &api.RoomEvent{Event: &api.RoomEvent_AnswerAccepted{AnswerAccepted: &api.RoomAnswerAcceptedEvent{
+		QuestionId: cmd.QuestionId,
+	}}}, nil
+}
+
+// roomPoints дает полный балл за мгновенный ответ, к концу времени он падает вдвое
+func roomPoints(credit float32, elapsed, limit time.Duration) int32 {
+	if limit <= 0 {
+		limit = roomAnswerTime
+	}
+	left := max(0, 1-elapsed.Seconds()/limit.Seconds())
+	return int32(math.Round(float64(credit) * maxRoomPoints * (0.5 + 0.5*left)))
+}
+
+func roomProgress(room *rooms.Room) (answered, connected int) {
+	for _, player := range room.Players {
+		if !player.Connected {
+			continue
+		}
+		connected++
+		if player.Answered == room.Question {
+			answered++
+		}
+	}
+	return answered, connected
+}
+
+// leave закрывает комнату, если ушел хост. Игрок уходит из лобби насовсем,
+// а после начала игры остается в таблице лидеров.
+func (s *RoomService) leave(ctx context.Context, p *participant) {
+	if p.host {
+		if room, err := s.store.Get(ctx, p.roomID); err == nil && p.check(room) == nil {
+			s.closeRoom(ctx, p, "the host left")
+		}
+		return
+	}
+	room, err := s.store.Update(ctx, p.roomID, func(room *rooms.Room) error {
+		if err := p.check(room); err != nil {
+			return err
+		}
+		if room.Phase == rooms.PhaseLobby {
+			room.Players = slices.DeleteFunc(room.Players, func(player rooms.Player) bool { return player.Nickname == p.nickname })
+		} else if player := room.Player(p.nickname); player != nil {
+			player.Connected = false
+		}
+		return nil
+	})
+	if err == nil {
+		s.publish(ctx, room.ID, playersEvent(room))
+	}
+}
+
+func (s *RoomService) closeRoom(ctx context.Context, p *participant, reason string) error {
+	err := s.publish(ctx, p.roomID, &api.RoomEvent{Event: &api.RoomEvent_Closed{Closed: &api.RoomClosedEvent{Reason: reason}}})
+	if err := s.store.Delete(ctx, p.roomID); err != nil {
+		return roomError(err)
+	}
+	return err
+}
+
+// recordTeams отправляет итоги командной игры в stat_service. История команд не
+// должна мешать игре, поэтому ошибка только пишется в лог. Игра, где играла
+// всего одна команда, в историю не попадает.
+func (s *RoomService) recordTeams(ctx context.Context, room *rooms.Room) {
+	standings := room.TeamStandings()
+	if s.stats == nil || len(standings) < 2 {
+		return
+	}
+	req := &statapi.RecordTeamResultsRequest{
+		Source:   "room",
+		SourceId: fmt.Sprintf("%s:%d", room.ID, room.Opened.UnixMilli()),
+		QuizId:   room.QuizID,
+		Owner:    room.Host,
+	}
+	if room.TeamScoring == rooms.ScoringSum {
+		req.Scoring = statapi.TeamScoring_TEAM_SCORING_SUM
+	}
+	for _, team := range standings {
+		req.Teams = append(req.Teams, &statapi.TeamResult{
+			Team:       team.Name,
+			Score:      team.Score,
+			Rank:       team.Rank,
+			NumPlayers: team.Players,
+		})
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), teamStatsTimeout)
+	defer cancel()
+	if _, err := s.stats.RecordTeamResults(ctx, req); err != nil {
+		s.l.Error(ctx, "failed to record team results", zap.String("room", room.ID), zap.Error(err))
+	}
+}
+
+func (s *RoomService) publish(ctx context.Context, roomID string, event *api.RoomEvent) error {
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to encode room event")
+	}
+	if err = s.store.Publish(ctx, roomID, data); err != nil {
+		return roomError(err)
+	}
+	return nil
+}
+
+// roomError переводит ошибки хранилища в статусы gRPC, ошибки правил игры уже статусы
+func roomError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, rooms.ErrNotFound):
+		return status.Error(codes.NotFound, "room is not open")
+	case errors.Is(err, rooms.ErrConflict):
+		return status.Error(codes.Aborted, err.Error())
+	default:
+		return status.Error(codes.Unavailable, err.Error())
+	}
+}
+
+func errorEvent(err error) *api.RoomEvent {
+	return &api.RoomEvent{Event: &api.RoomEvent_Error{Error: &api.RoomErrorEvent{Message: status.Convert(err).Message()}}}
+}
+
+func joinedEvent(p *participant) *api.RoomEvent {
+	return &api.RoomEvent{Event: &api.RoomEvent_Joined{Joined: &api.RoomJoinedEvent{
+		RoomId:         p.roomID,
+		Nickname:       p.nickname,
+		Host:           p.host,
+		QuizName:       p.quiz.Name,
+		TotalQuestions: int32(len(p.quiz.Question)),
+		Team:           p.team,
+	}}}
+}
+
+func playersEvent(room *rooms.Room) *api.RoomEvent {
+	var nicknames []string
+	teams := make([]*api.RoomTeam, len(room.Teams))
+	for i, name := range room.Teams {
+		teams[i] = &api.RoomTeam{Name: name}
+	}
+	for _, player := range room.Players {
+		if !player.Connected {
+			continue
+		}
+		nicknames = append(nicknames, player.Nickname)
+		if i := slices.Index(room.Teams, player.Team); i >= 0 {
+			teams[i].Nicknames = append(teams[i].Nicknames, player.Nickname)
+		}
+	}
+	return &api.RoomEvent{Event: &api.RoomEvent_Players{Players: &api.RoomPlayersEvent{Nicknames: nicknames, Teams: teams}}}
+}
+
+// questionEvent показывает вопрос без правильных ответов, как в StartSession
+func questionEvent(quiz *api.GetQuizResponse, room *rooms.Room) *api.RoomEvent {
+	question := proto.Clone(quiz.Question[room.Question]).(*api.CreateQuestion)
+	// Все участники и переподключившиеся видят один и тот же порядок, пока комната открыта
+	hideAnswers(&api.GetQuizResponse{Question: []*api.CreateQuestion{question}}, room.Opened.UnixNano())
+	return &api.RoomEvent{Event: &api.RoomEvent_Question{Question: &api.RoomQuestionEvent{
+		Index:            int32(room.Question),
+		Total:            int32(len(quiz.Question)),
+		Question:         question,
+		TimeLimitSeconds: question.TimeLimitSeconds,
+	}}}
+}
+
+func resultsEvent(quiz *api.GetQuizResponse, room *rooms.Room) *api.RoomEvent {
+	question := quiz.Question[room.Question]
+	var correct []string
+	for _, a := range question.Answer {
+		if a.IsCorrect {
+			correct = append(correct, a.AnswerId)
+		}
+	}
+	return &api.RoomEvent{Event: &api.RoomEvent_Results{Results: &api.RoomResultsEvent{
+		QuestionId:      question.QuestionId,
+		CorrectAnswerId: correct,
+		Leaderboard:     leaderboard(room.Players),
+		Final:           room.Question == len(quiz.Question)-1,
+		Teams:           teamScores(room),
+	}}}
+}
+
+func teamScores(room *rooms.Room) []*api.RoomTeamScore {
+	var scores []*api.RoomTeamScore
+	for _, team := range room.TeamStandings() {
+		scores = append(scores, &api.RoomTeamScore{
+			Name:    team.Name,
+			Score:   team.Score,
+			Rank:    team.Rank,
+			Players: team.Players,
+		})
+	}
+	return scores
+}
+
+// leaderboard сортирует игроков по очкам, при равенстве очков место общее
+func leaderboard(players []rooms.Player) []*api.RoomScore {
+	players = slices.Clone(players)
+	slices.SortStableFunc(players, func(a, b rooms.Player) int {
+		if a.Score != b.Score {
+			return int(b.Score - a.Score)
+		}
+		return strings.Compare(a.Nickname, b.Nickname)
+	})
+	scores := make([]*api.RoomScore, len(players))
+	for i, player := range players {
+		rank := int32(i + 1)
+		if i > 0 && player.Score == players[i-1].Score {
+			rank = scores[i-1].Rank
+		}
+		scores[i] = &api.RoomScore{
+			Nickname:   player.Nickname,
+			Score:      player.Score,
+			Rank:       rank,
+			LastPoints: player.LastPoints,
+			Correct:    player.Correct,
+			Team:       player.Team,
+		}
+	}
+	return scores
+}
@@ -2,32 +2,99 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"hash/fnv"
+	"quizzes/internal/quizzes/daily"
+	"quizzes/internal/quizzes/repository"
 	api "quizzes/pkg/api/v1"
 	v1 "quizzes/pkg/api/v1"
+	"quizzes/pkg/media"
+	"strings"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Repository interface {
-	CreateQuiz(context.Context, string, string, *string, *string, []*v1.CreateQuestion) (string, string, error)
-	GetQuiz(context.Context, string) (*v1.GetQuizResponse, error)
-	GetQuizByAuthor(context.Context, string) (*v1.GetQuizByAuthorResponse, error)
-	ListAll(context.Context) (*v1.ListAllResponse, error)
+	CreateQuiz(context.Context, string, string, *string, *string, []*v1.CreateQuestion, []string, string, v1.QuizVisibility, *v1.QuizTiming, *v1.QuizShuffle) (string, string, error)
+	GetQuiz(context.Context, string, int32) (*v1.GetQuizResponse, error)
+	GetVisibleQuiz(context.Context, string, int32, string) (*v1.GetQuizResponse, error)
+	GetQuizByAuthor(context.Context, string, string) (*v1.GetQuizByAuthorResponse, error)
+	ListAll(context.Context, *v1.ListAllRequest) (*v1.ListAllResponse, error)
+	SearchQuizzes(context.Context, *v1.SearchQuizzesRequest) (*v1.SearchQuizzesResponse, error)
+	ListCategories(context.Context) (*v1.ListCategoriesResponse, error)
+	GetPopularTags(context.Context, int32, string) (*v1.GetPopularTagsResponse, error)
+	CurrentUser(context.Context) (string, error)
+	UpdateQuiz(context.Context, string, string, string, *string, *string, []*v1.CreateQuestion, []string, string, v1.QuizVisibility, *v1.QuizTiming, *v1.QuizShuffle) (int32, error)
+	DeleteQuiz(context.Context, string, string) error
+	SaveDraft(context.Context, string, string, string, *string, *string, []*v1.CreateQuestion, []string, string, v1.QuizVisibility, *v1.QuizTiming, *v1.QuizShuffle) (string, int32, error)
+	PublishQuiz(context.Context, string, string, int32) error
+	ArchiveQuiz(context.Context, string, string) error
+	CloneQuiz(context.Context, string, string) (string, string, error)
+
+	StartSession(context.Context, string, string) (*v1.StartSessionResponse, error)
+	SubmitAnswer(context.Context, string, *v1.SubmitAnswerRequest) (*v1.SubmitAnswerResponse, error)
+	TakeHint(context.Context, string, string, string) (*v1.TakeHintResponse, error)
+	FinishSession(context.Context, string, string) (*v1.FinishSessionResponse, error)
+	GetSessionResult(context.Context, string, string) (*v1.GetSessionResultResponse, error)
+
+	QuizSummary(context.Context, string) (*v1.QuizSummary, error)
+	DailySession(context.Context, time.Time, string) (string, bool, error)
+	StartDailySession(context.Context, string, string, time.Time) (*v1.StartSessionResponse, error)
+
+	SaveImage(context.Context, string, string, int, *media.Image) error
+	GetImageType(context.Context, string, bool) (string, error)
 }
+
+// Размер страницы ListAll и SearchQuizzes
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// Размер облака тегов
+const (
+	defaultPopularTags = 50
+	maxPopularTags     = 200
+)
+
 type QuizService struct {
 	api.QuizServiceServer
-	repo Repository
+	repo         Repository
+	images       media.Storage
+	maxImageSize int
+	daily        *daily.Scheduler
 }
 
-func New(ctx context.Context, repo Repository) *QuizService {
-	return &QuizService{repo: repo}
+func New(ctx context.Context, repo Repository, images media.Storage, maxImageSize int, daily *daily.Scheduler) *QuizService {
+	return &QuizService{repo: repo, images: images, maxImageSize: maxImageSize, daily: daily}
 }
 
 func Register(grpcServer *grpc.Server) {
 	api.RegisterQuizServiceServer(grpcServer, &QuizService{})
 }
 func (s *QuizService) CreateQuiz(ctx context.Context, req *api.CreateQuizRequest) (*api.CreateQuizResponse, error) {
-	quiz_id, uuID, err := s.repo.CreateQuiz(ctx, req.Name, req.Author, req.ImageId, req.Description, req.Question)
+	// CreateQuiz сразу публикует квиз, незаконченные квизы сохраняются через SaveDraft
+	if err := validateQuiz(req.Name, req.Question, req.Tags); err != nil {
+		return nil, err
+	}
+	if err := validateTiming(req.Timing); err != nil {
+		return nil, err
+	}
+	if err := validateShuffle(req.Shuffle, req.Question); err != nil {
+		return nil, err
+	}
+	// Автор - владелец токена, иначе можно было бы создать квиз от чужого имени
+	user, err := s.repo.CurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Author != "" && req.Author != user {
+		return nil, status.Error(codes.PermissionDenied, "author must be the caller")
+	}
+	quiz_id, uuID, err := s.repo.CreateQuiz(ctx, req.Name, user, req.ImageId, req.Description, req.Question, req.Tags, req.CategoryId, req.Visibility, req.Timing, req.Shuffle)
 	if err != nil {
 		//		logger.GetLoggerFromCtx(ctx).Error(ctx, err.Error())
 		return nil, err
@@ -36,27 +103,327 @@ func (s *QuizService) CreateQuiz(ctx context.Context, req *api.CreateQuizRequest
 	return &api.CreateQuizResponse{QuizId: uuID, ShortId: quiz_id}, nil
 }
 func (s *QuizService) GetQuiz(ctx context.Context, req *api.GetQuizRequest) (*api.GetQuizResponse, error) {
-	resp, err := s.repo.GetQuiz(ctx, req.QuizId)
+	user, _ := s.repo.CurrentUser(ctx)
+	resp, err := s.repo.GetVisibleQuiz(ctx, req.QuizId, req.Revision, user)
 	if err != nil {
 		//		logger.GetLoggerFromCtx(ctx).Error(ctx, err.Error())
 		return nil, err
 	}
 	//	logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("got quiz: %s", req.QuizId))
+	s.hideForeignAnswers(ctx, resp)
 	return resp, nil
 }
 func (s *QuizService) GetQuizByAuthor(ctx context.Context, req *api.GetQuizByAuthorRequest) (*api.GetQuizByAuthorResponse, error) {
-	resp, err := s.repo.GetQuizByAuthor(ctx, req.Author)
+	user, _ := s.repo.CurrentUser(ctx)
+	resp, err := s.repo.GetQuizByAuthor(ctx, req.Author, user)
 	if err != nil {
 		//		logger.GetLoggerFromCtx(ctx).Error(ctx, err.Error())
 		return nil, err
 	}
 	//	logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("got quiz: %s", req.QuizId))
+	for _, quizzes := range resp.AuthorQuizzes {
+		s.hideForeignAnswers(ctx, quizzes.Quizzes...)
+	}
 	return resp, nil
 }
 func (s *QuizService) ListAll(ctx context.Context, req *api.ListAllRequest) (*api.ListAllResponse, error) {
-	resp, err := s.repo.ListAll(ctx)
+	switch {
+	case req.PageSize < 0 || req.PageSize > maxPageSize:
+		return nil, status.Errorf(codes.InvalidArgument, "page_size must be between 0 and %d", maxPageSize)
+	case req.PageSize == 0:
+		req.PageSize = defaultPageSize
+	}
+	return s.repo.ListAll(ctx, req)
+}
+func (s *QuizService) SearchQuizzes(ctx context.Context, req *api.SearchQuizzesRequest) (*api.SearchQuizzesResponse, error) {
+	if strings.TrimSpace(req.Query) == "" {
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	}
+	switch {
+	case req.PageSize < 0 || req.PageSize > maxPageSize:
+		return nil, status.Errorf(codes.InvalidArgument, "page_size must be between 0 and %d", maxPageSize)
+	case req.PageSize == 0:
+		req.PageSize = defaultPageSize
+	}
+	return s.repo.SearchQuizzes(ctx, req)
+}
+func (s *QuizService) ListCategories(ctx context.Context, req *api.ListCategoriesRequest) (*api.ListCategoriesResponse, error) {
+	return s.repo.ListCategories(ctx)
+}
+func (s *QuizService) ListQuizzesByTag(ctx context.Context, req *api.ListQuizzesByTagRequest) (*api.ListAllResponse, error) {
+	if req.Tag == "" && req.CategoryId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tag or category_id is required")
+	}
+	return s.ListAll(ctx, &api.ListAllRequest{
+		PageSize:   req.PageSize,
+		PageToken:  req.PageToken,
+		Sort:       req.Sort,
+		Tag:        req.Tag,
+		CategoryId: req.CategoryId,
+	})
+}
+func (s *QuizService) GetPopularTags(ctx context.Context, req *api.GetPopularTagsRequest) (*api.GetPopularTagsResponse, error) {
+	switch {
+	case req.Limit < 0 || req.Limit > maxPopularTags:
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 0 and %d", maxPopularTags)
+	case req.Limit == 0:
+		req.Limit = defaultPopularTags
+	}
+	return s.repo.GetPopularTags(ctx, req.Limit, req.CategoryId)
+}
+func (s *QuizService) UpdateQuiz(ctx context.Context, req *api.UpdateQuizRequest) (*api.UpdateQuizResponse, error) {
+	if req.QuizId == "" {
+		return nil, status.Error(codes.InvalidArgument, "quiz_id is required")
+	}
+	if err := validateQuiz(req.Name, req.Question, req.Tags); err != nil {
+		return nil, err
+	}
+	if err := validateTiming(req.Timing); err != nil {
+		return nil, err
+	}
+	if err := validateShuffle(req.Shuffle, req.Question); err != nil {
+		return nil, err
+	}
+	user, err := s.repo.CurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	revision, err := s.repo.UpdateQuiz(ctx, user, req.QuizId, req.Name, req.ImageId, req.Description, req.Question, req.Tags, req.CategoryId, req.Visibility, req.Timing, req.Shuffle)
+	if err != nil {
+		return nil, err
+	}
+	return &api.UpdateQuizResponse{QuizId: req.QuizId, Message: "quiz updated", Revision: revision}, nil
+}
+func (s *QuizService) DiffRevisions(ctx context.Context, req *api.DiffRevisionsRequest) (*api.DiffRevisionsResponse, error) {
+	if req.QuizId == "" || req.From <= 0 || req.To <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "quiz_id, from and to are required")
+	}
+	from, err := s.authorRevision(ctx, req.QuizId, req.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.authorRevision(ctx, req.QuizId, req.To)
+	if err != nil {
+		return nil, err
+	}
+	return &api.DiffRevisionsResponse{
+		QuizId:    req.QuizId,
+		From:      from.Revision,
+		To:        to.Revision,
+		Fields:    diffFields(from, to),
+		Questions: diffQuestions(from.Question, to.Question),
+	}, nil
+}
+func (s *QuizService) RollbackQuiz(ctx context.Context, req *api.RollbackQuizRequest) (*api.UpdateQuizResponse, error) {
+	if req.QuizId == "" || req.Revision <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "quiz_id and revision are required")
+	}
+	old, err := s.authorRevision(ctx, req.QuizId, req.Revision)
 	if err != nil {
 		return nil, err
 	}
+	if err = validateQuiz(old.Name, old.Question, old.Tags); err != nil {
+		return nil, err
+	}
+	if err = validateShuffle(old.Shuffle, old.Question); err != nil {
+		return nil, err
+	}
+	// Откат не переписывает историю, а сохраняет старое содержимое как новую ревизию
+	revision, err := s.repo.UpdateQuiz(ctx, old.Author, req.QuizId, old.Name, old.ImageId, old.Description, old.Question, old.Tags, old.CategoryId, old.Visibility, old.Timing, old.Shuffle)
+	if err != nil {
+		return nil, err
+	}
+	return &api.UpdateQuizResponse{QuizId: req.QuizId, Message: "quiz rolled back", Revision: revision}, nil
+}
+func (s *QuizService) DeleteQuiz(ctx context.Context, req *api.DeleteQuizRequest) (*api.DeleteQuizResponse, error) {
+	if req.QuizId == "" {
+		return nil, status.Error(codes.InvalidArgument, "quiz_id is required")
+	}
+	user, err := s.repo.CurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.repo.DeleteQuiz(ctx, user, req.QuizId); err != nil {
+		return nil, err
+	}
+	return &api.DeleteQuizResponse{QuizId: req.QuizId, Message: "quiz deleted"}, nil
+}
+func (s *QuizService) SaveDraft(ctx context.Context, req *api.SaveDraftRequest) (*api.SaveDraftResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if err := validateTags(req.Tags); err != nil {
+		return nil, err
+	}
+	if err := validateTiming(req.Timing); err != nil {
+		return nil, err
+	}
+	user, err := s.repo.CurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	quizID, revision, err := s.repo.SaveDraft(ctx, user, req.QuizId, req.Name, req.ImageId, req.Description, req.Question, req.Tags, req.CategoryId, req.Visibility, req.Timing, req.Shuffle)
+	if err != nil {
+		return nil, err
+	}
+	return &api.SaveDraftResponse{QuizId: quizID, Revision: revision, Message: "draft saved"}, nil
+}
+
+// CloneQuiz делает черновик-копию чужого или своего квиза от имени вызывающего
+func (s *QuizService) CloneQuiz(ctx context.Context, req *api.CloneQuizRequest) (*api.CloneQuizResponse, error) {
+	if req.QuizId == "" {
+		return nil, status.Error(codes.InvalidArgument, "quiz_id is required")
+	}
+	user, err := s.repo.CurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	shortID, quizID, err := s.repo.CloneQuiz(ctx, user, req.QuizId)
+	if err != nil {
+		return nil, err
+	}
+	return &api.CloneQuizResponse{QuizId: quizID, ShortId: shortID, ForkedFrom: req.QuizId, Message: "quiz cloned"}, nil
+}
+func (s *QuizService) PublishQuiz(ctx context.Context, req *api.PublishQuizRequest) (*api.PublishQuizResponse, error) {
+	if req.QuizId == "" {
+		return nil, status.Error(codes.InvalidArgument, "quiz_id is required")
+	}
+	quiz, err := s.authorRevision(ctx, req.QuizId, 0)
+	if err != nil {
+		return nil, err
+	}
+	if err = validateQuiz(quiz.Name, quiz.Question, quiz.Tags); err != nil {
+		return nil, err
+	}
+	if err = validateShuffle(quiz.Shuffle, quiz.Question); err != nil {
+		return nil, err
+	}
+	if err = s.repo.PublishQuiz(ctx, quiz.Author, req.QuizId, quiz.Revision); err != nil {
+		return nil, err
+	}
+	return &api.PublishQuizResponse{QuizId: req.QuizId, Revision: quiz.Revision, Message: "quiz published"}, nil
+}
+func (s *QuizService) ArchiveQuiz(ctx context.Context, req *api.ArchiveQuizRequest) (*api.ArchiveQuizResponse, error) {
+	if req.QuizId == "" {
+		return nil, status.Error(codes.InvalidArgument, "quiz_id is required")
+	}
+	user, err := s.repo.CurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.repo.ArchiveQuiz(ctx, user, req.QuizId); err != nil {
+		return nil, err
+	}
+	return &api.ArchiveQuizResponse{QuizId: req.QuizId, Message: "quiz archived"}, nil
+}
+func (s *QuizService) StartSession(ctx context.Context, req *api.StartSessionRequest) (*api.StartSessionResponse, error) {
+	if req.QuizId == "" {
+		return nil, status.Error(codes.InvalidArgument, "quiz_id is required")
+	}
+	// Играть можно и без авторизации, но тогда результат не попадет в статистику
+	player, _ := s.repo.CurrentUser(ctx)
+	resp, err := s.repo.StartSession(ctx, req.QuizId, player)
+	if err != nil {
+		return nil, err
+	}
+	hideAnswers(resp.Quiz, resp.Seed)
 	return resp, nil
 }
+func (s *QuizService) SubmitAnswer(ctx context.Context, req *api.SubmitAnswerRequest) (*api.SubmitAnswerResponse, error) {
+	if req.SessionId == "" || req.QuestionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id and question_id are required")
+	}
+	// Анонимную сессию ведут без токена, чужую сессию репозиторий не отдаст
+	player, _ := s.repo.CurrentUser(ctx)
+	return s.repo.SubmitAnswer(ctx, player, req)
+}
+func (s *QuizService) TakeHint(ctx context.Context, req *api.TakeHintRequest) (*api.TakeHintResponse, error) {
+	if req.SessionId == "" || req.QuestionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id and question_id are required")
+	}
+	player, _ := s.repo.CurrentUser(ctx)
+	return s.repo.TakeHint(ctx, player, req.SessionId, req.QuestionId)
+}
+func (s *QuizService) FinishSession(ctx context.Context, req *api.FinishSessionRequest) (*api.FinishSessionResponse, error) {
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	player, _ := s.repo.CurrentUser(ctx)
+	return s.repo.FinishSession(ctx, player, req.SessionId)
+}
+func (s *QuizService) GetSessionResult(ctx context.Context, req *api.GetSessionResultRequest) (*api.GetSessionResultResponse, error) {
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	player, _ := s.repo.CurrentUser(ctx)
+	return s.repo.GetSessionResult(ctx, player, req.SessionId)
+}
+
+// authorRevision загружает ревизию квиза, если вызывающий является его автором
+func (s *QuizService) authorRevision(ctx context.Context, quizID string, revision int32) (*api.GetQuizResponse, error) {
+	user, err := s.repo.CurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	quiz, err := s.repo.GetQuiz(ctx, quizID, revision)
+	if err != nil {
+		return nil, err
+	}
+	if quiz.Author != user {
+		return nil, status.Error(codes.PermissionDenied, "only the author can manage the quiz")
+	}
+	return quiz, nil
+}
+
+// hideForeignAnswers скрывает правильные ответы во всех квизах, автор которых не совпадает с вызывающим.
+// Вне сессии порядок ответов задается ревизией квиза и одинаков при каждом запросе.
+func (s *QuizService) hideForeignAnswers(ctx context.Context, quizzes ...*api.GetQuizResponse) {
+	user, err := s.repo.CurrentUser(ctx)
+	// Квиз автора может оказаться и в его избранном, скрывать его второй раз нельзя
+	hidden := make(map[*api.GetQuizResponse]bool, len(quizzes))
+	for _, quiz := range quizzes {
+		if hidden[quiz] {
+			continue
+		}
+		if err != nil || user == "" || quiz.Author != user {
+			hideAnswers(quiz, revisionSeed(quiz))
+			hidden[quiz] = true
+		}
+	}
+}
+
+// revisionSeed выводит зерно порядка ответов из короткого ID и ревизии квиза
+func revisionSeed(quiz *api.GetQuizResponse) int64 {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s/%d", quiz.ShortID, quiz.Revision)
+	return int64(h.Sum64())
+}
+
+// hideAnswers убирает из квиза все, по чему можно подсмотреть правильный ответ при игре.
+// Ответы на упорядочивание и варианты сопоставления перемешиваются по seed, обычно зерну сессии.
+func hideAnswers(quiz *api.GetQuizResponse, seed int64) {
+	repository.PlayOrder(quiz.Question, seed)
+	for _, q := range quiz.Question {
+		for _, a := range q.Answer {
+			a.IsCorrect = false
+			a.Feedback = ""
+		}
+		q.NumericAnswer = nil
+		q.NumericTolerance = 0
+		// Пояснение приходит после ответа, от подсказок видна только цена
+		q.Explanation = nil
+		for _, h := range q.Hints {
+			h.Text = ""
+		}
+		switch q.QuestionType {
+		case api.QuestionType_QUESTION_TYPE_FREE_TEXT:
+			// Ответы свободного ввода и есть принимаемые тексты
+			q.Answer = nil
+		case api.QuestionType_QUESTION_TYPE_MATCHING:
+			// Варианты уже собраны в MatchOptions, пары остаются только в базе
+			for _, a := range q.Answer {
+				a.MatchText = ""
+			}
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"quizzes/internal/quizzes/formats"
+	api "quizzes/pkg/api/v1"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Ограничение на размер импортируемого файла
+const maxImportSize = 1 << 20
+
+func (s *QuizService) ExportQuiz(ctx context.Context, req *api.ExportQuizRequest) (*httpbody.HttpBody, error) {
+	if req.QuizId == "" {
+		return nil, status.Error(codes.InvalidArgument, "quiz_id is required")
+	}
+	quiz, err := s.authorRevision(ctx, req.QuizId, req.Revision)
+	if err != nil {
+		return nil, err
+	}
+	data, contentType, err := formats.Export(&api.CreateQuizRequest{
+		Name:        quiz.Name,
+		Author:      quiz.Author,
+		ImageId:     quiz.ImageId,
+		Description: quiz.Description,
+		Question:    quiz.Question,
+		Tags:        quiz.Tags,
+		CategoryId:  quiz.CategoryId,
+		Timing:      quiz.Timing,
+		Shuffle:     quiz.Shuffle,
+	}, req.Format)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &httpbody.HttpBody{ContentType: contentType, Data: data}, nil
+}
+
+// ImportQuiz создает опубликованный квиз из файла. Ошибки в файле возвращаются списком
+// в ответе вместе со строками, где они найдены, и квиз тогда не создается.
+func (s *QuizService) ImportQuiz(ctx context.Context, req *api.ImportQuizRequest) (*api.ImportQuizResponse, error) {
+	if len(req.Content) > maxImportSize {
+		return nil, status.Errorf(codes.InvalidArgument, "content is larger than %d bytes", maxImportSize)
+	}
+	user, err := s.repo.CurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := formats.Import(req.Format, req.Content)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	quiz := parsed.Quiz
+	quiz.Author = user
+	if req.Name != "" {
+		quiz.Name = req.Name
+	}
+	if req.Description != nil {
+		quiz.Description = req.Description
+	}
+	if len(req.Tags) > 0 {
+		quiz.Tags = req.Tags
+	}
+	if req.CategoryId != "" {
+		quiz.CategoryId = req.CategoryId
+	}
+	quiz.Visibility = req.Visibility
+
+	resp := &api.ImportQuizResponse{DryRun: req.DryRun, Quiz: quiz, Errors: parsed.Errors}
+	if len(resp.Errors) == 0 {
+		resp.Errors = validateImport(quiz, parsed.Lines)
+	}
+	switch {
+	case len(resp.Errors) > 0:
+		resp.Message = fmt.Sprintf("found %d problems, nothing was imported", len(resp.Errors))
+		return resp, nil
+	case req.DryRun:
+		resp.Message = fmt.Sprintf("%d questions would be imported", len(quiz.Question))
+		return resp, nil
+	}
+	shortID, quizID, err := s.repo.CreateQuiz(ctx, quiz.Name, quiz.Author, quiz.ImageId, quiz.Description, quiz.Question, quiz.Tags, quiz.CategoryId, quiz.Visibility, quiz.Timing, quiz.Shuffle)
+	if err != nil {
+		return nil, err
+	}
+	resp.QuizId, resp.ShortId = quizID, shortID
+	resp.Message = fmt.Sprintf("imported %d questions", len(quiz.Question))
+	return resp, nil
+}
+
+// validateImport проверяет квиз теми же правилами, что и validateQuiz, но привязывает
+// каждую проблему к строке файла, где начинается вопрос
+func validateImport(quiz *api.CreateQuizRequest, lines []int32) []*api.ImportError {
+	var problems []*api.ImportError
+	if strings.TrimSpace(quiz.Name) == "" {
+		problems = append(problems, &api.ImportError{Field: "name", Message: "name is required"})
+	}
+	if err := validateTags(quiz.Tags); err != nil {
+		problems = append(problems, &api.ImportError{Field: "tags", Message: status.Convert(err).Message()})
+	}
+	if err := validateTiming(quiz.Timing); err != nil {
+		problems = append(problems, &api.ImportError{Field: "timing", Message: status.Convert(err).Message()})
+	}
+	for _, problem := range shuffleProblems(quiz.Shuffle, quiz.Question) {
+		problems = append(problems, &api.ImportError{Field: "shuffle", Message: problem})
+	}
+	if len(quiz.Question) == 0 {
+		problems = append(problems, &api.ImportError{Field: "question", Message: "at least one question is required"})
+	}
+	for i, q := range quiz.Question {
+		var line int32
+		if i < len(lines) {
+			line = lines[i]
+		}
+		field := fmt.Sprintf("question %d", i+1)
+		if strings.TrimSpace(q.QuestionText) == "" {
+			problems = append(problems, &api.ImportError{Line: line, Field: field, Message: "text is required"})
+		}
+		for _, problem := range validateQuestion(q) {
+			problems = append(problems, &api.ImportError{Line: line, Field: field, Message: problem})
+		}
+	}
+	return problems
+}
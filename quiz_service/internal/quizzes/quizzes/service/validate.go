@@ -0,0 +1,171 @@
+package service
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	api "quizzes/pkg/api/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validateQuiz проверяет, что квиз можно опубликовать. Возвращает все найденные
+// проблемы сразу, чтобы автору не приходилось исправлять их по одной.
+func validateQuiz(name string, questions []*api.CreateQuestion, tags []string) error {
+	var problems []string
+	if err := validateTags(tags); err != nil {
+		problems = append(problems, status.Convert(err).Message())
+	}
+	if strings.TrimSpace(name) == "" {
+		problems = append(problems, "name is required")
+	}
+	if len(questions) == 0 {
+		problems = append(problems, "at least one question is required")
+	}
+	for i, q := range questions {
+		if strings.TrimSpace(q.QuestionText) == "" {
+			problems = append(problems, fmt.Sprintf("question %d: text is required", i+1))
+		}
+		for _, problem := range validateQuestion(q) {
+			problems = append(problems, fmt.Sprintf("question %d: %s", i+1, problem))
+		}
+	}
+	if len(problems) > 0 {
+		return status.Error(codes.InvalidArgument, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// validateQuestion проверяет ответы вопроса по правилам его типа
+func validateQuestion(q *api.CreateQuestion) []string {
+	var problems []string
+	correct := 0
+	for j, a := range q.Answer {
+		if strings.TrimSpace(a.AnswerText) == "" {
+			problems = append(problems, fmt.Sprintf("answer %d: text is required", j+1))
+		}
+		if q.QuestionType == api.QuestionType_QUESTION_TYPE_MATCHING && strings.TrimSpace(a.MatchText) == "" {
+			problems = append(problems, fmt.Sprintf("answer %d: match_text is required", j+1))
+		}
+		if a.IsCorrect {
+			correct++
+		}
+	}
+
+	for j, h := range q.Hints {
+		if strings.TrimSpace(h.Text) == "" {
+			problems = append(problems, fmt.Sprintf("hint %d: text is required", j+1))
+		}
+		if h.Cost < 0 || h.Cost > 1 {
+			problems = append(problems, fmt.Sprintf("hint %d: cost must be between 0 and 1", j+1))
+		}
+	}
+	if q.TimeLimitSeconds < 0 || q.TimeLimitSeconds > maxTimeLimit {
+		problems = append(problems, fmt.Sprintf("time_limit_seconds must be between 0 and %d", maxTimeLimit))
+	}
+
+	switch q.QuestionType {
+	case api.QuestionType_QUESTION_TYPE_SINGLE_CHOICE:
+		if len(q.Answer) < 2 {
+			problems = append(problems, "at least two answers are required")
+		}
+		if correct != 1 {
+			problems = append(problems, "exactly one correct answer is required")
+		}
+	case api.QuestionType_QUESTION_TYPE_MULTI_SELECT:
+		if len(q.Answer) < 2 {
+			problems = append(problems, "at least two answers are required")
+		}
+		if correct == 0 {
+			problems = append(problems, "at least one correct answer is required")
+		}
+	case api.QuestionType_QUESTION_TYPE_TRUE_FALSE:
+		if len(q.Answer) != 2 || correct != 1 {
+			problems = append(problems, "exactly two answers with one correct are required")
+		}
+	case api.QuestionType_QUESTION_TYPE_FREE_TEXT:
+		if len(q.Answer) == 0 {
+			problems = append(problems, "at least one accepted answer is required")
+		}
+	case api.QuestionType_QUESTION_TYPE_NUMERIC:
+		if q.NumericAnswer == nil {
+			problems = append(problems, "numeric_answer is required")
+		}
+		if q.NumericTolerance < 0 {
+			problems = append(problems, "numeric_tolerance must not be negative")
+		}
+	case api.QuestionType_QUESTION_TYPE_ORDERING, api.QuestionType_QUESTION_TYPE_MATCHING:
+		if len(q.Answer) < 2 {
+			problems = append(problems, "at least two answers are required")
+		}
+	default:
+		problems = append(problems, "unknown question_type")
+	}
+	return problems
+}
+
+// Ограничения на теги квиза
+const (
+	maxTags      = 10
+	maxTagLength = 32
+)
+
+func validateTags(tags []string) error {
+	if len(tags) > maxTags {
+		return status.Errorf(codes.InvalidArgument, "at most %d tags are allowed", maxTags)
+	}
+	for _, tag := range tags {
+		if utf8.RuneCountInString(strings.TrimSpace(tag)) > maxTagLength {
+			return status.Errorf(codes.InvalidArgument, "tag %q is longer than %d characters", tag, maxTagLength)
+		}
+	}
+	return nil
+}
+
+// maxTimeLimit ограничивает время на вопрос и на весь квиз, в секундах
+const maxTimeLimit = 24 * 60 * 60
+
+func validateTiming(timing *api.QuizTiming) error {
+	if limit := timing.GetTimeLimitSeconds(); limit < 0 || limit > maxTimeLimit {
+		return status.Errorf(codes.InvalidArgument, "time_limit_seconds must be between 0 and %d", maxTimeLimit)
+	}
+	return nil
+}
+
+// validateShuffle проверяет пулы вопросов: у каждого пула уникальное имя, и в нем хватает
+// вопросов на выборку, а вопросы ссылаются только на объявленные пулы
+func validateShuffle(shuffle *api.QuizShuffle, questions []*api.CreateQuestion) error {
+	if problems := shuffleProblems(shuffle, questions); len(problems) > 0 {
+		return status.Error(codes.InvalidArgument, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+func shuffleProblems(shuffle *api.QuizShuffle, questions []*api.CreateQuestion) []string {
+	var problems []string
+	sizes := make(map[string]int32)
+	for i, q := range questions {
+		if q.Pool != "" {
+			sizes[q.Pool]++
+		}
+		if q.Pool != "" && !slices.ContainsFunc(shuffle.GetPools(), func(p *api.QuestionPool) bool { return p.Name == q.Pool }) {
+			problems = append(problems, fmt.Sprintf("question %d: unknown pool %q", i+1, q.Pool))
+		}
+	}
+	seen := make(map[string]bool)
+	for _, pool := range shuffle.GetPools() {
+		switch {
+		case strings.TrimSpace(pool.Name) == "":
+			problems = append(problems, "pool name is required")
+		case seen[pool.Name]:
+			problems = append(problems, fmt.Sprintf("pool %q is defined twice", pool.Name))
+		case pool.Draw < 1 || pool.Draw > sizes[pool.Name]:
+			problems = append(problems, fmt.Sprintf("pool %q: draw must be between 1 and its %d questions", pool.Name, sizes[pool.Name]))
+		}
+		seen[pool.Name] = true
+	}
+	return problems
+}
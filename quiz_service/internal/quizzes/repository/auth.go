@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	pb "quizzes/pkg/authapi/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// bearerToken достает токен из заголовка authorization входящего запроса
+func bearerToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "metadata is not provided")
+	}
+
+	authHeaders := md.Get("authorization")
+	if len(authHeaders) == 0 {
+		return "", status.Error(codes.Unauthenticated, "authorization token is not provided")
+	}
+
+	token := strings.TrimPrefix(authHeaders[0], "Bearer ")
+	if token == "" {
+		return "", status.Error(codes.Unauthenticated, "invalid auth token format")
+	}
+	return token, nil
+}
+
+// withBearerToken пробрасывает токен в исходящий запрос к auth_service
+func withBearerToken(ctx context.Context, token string) context.Context {
+	return metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+}
+
+// CurrentUser возвращает имя пользователя, которому принадлежит токен запроса
+func (r *Repository) CurrentUser(ctx context.Context) (string, error) {
+	token, err := bearerToken(ctx)
+	if err != nil {
+		return "", err
+	}
+	if r.auth == nil {
+		return "", status.Error(codes.Unavailable, "auth service is not available")
+	}
+	resp, err := r.auth.ValidateToken(withBearerToken(ctx, token), &pb.ValidateTokenRequest{Token: "Bearer " + token})
+	if err != nil {
+		return "", status.Error(codes.Unauthenticated, "invalid token")
+	}
+	return resp.GetUser().GetUsername(), nil
+}
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "quizzes/pkg/api/v1"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// categoryTree возвращает подзапрос с категорией param и всеми ее подкатегориями
+func categoryTree(param string) string {
+	return `WITH RECURSIVE tree AS (
+		SELECT Category_ID FROM categories WHERE Category_ID = ` + param + `
+		UNION ALL
+		SELECT c.Category_ID FROM categories c JOIN tree ON c.Parent_ID = tree.Category_ID
+	) SELECT Category_ID FROM tree`
+}
+
+// setCategory задает категорию квиза, пустая строка убирает ее
+func setCategory(ctx context.Context, tx pgx.Tx, quizID string, category string) error {
+	_, err := tx.Exec(ctx,
+		"UPDATE quizzes SET Category_ID = NULLIF($2, '') WHERE Quiz_ID = $1",
+		quizID, category)
+	if unknownCategory(err) {
+		return status.Error(codes.InvalidArgument, "unknown category_id")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set category: %w", err)
+	}
+	return nil
+}
+
+// unknownCategory проверяет, что запись отклонена внешним ключом: квиз уже заблокирован,
+// поэтому ссылаться на несуществующую строку может только категория
+func unknownCategory(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23503"
+}
+
+// ListCategories возвращает дерево категорий с числом опубликованных публичных квизов в каждой ветке
+func (r *Repository) ListCategories(ctx context.Context) (*v1.ListCategoriesResponse, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT c.Category_ID, COALESCE(c.Parent_ID, ''), c.Name,
+			(SELECT COUNT(*) FROM quizzes q WHERE q.Category_ID = c.Category_ID AND q.Status = $1 AND q.Visibility = $2)
+		FROM categories c
+		ORDER BY c.Position, c.Name`,
+		statusPublished, visibilityPublic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+	defer rows.Close()
+
+	byID := make(map[string]*v1.Category)
+	parents := make(map[string]string)
+	var order []string
+	for rows.Next() {
+		var parent string
+		category := &v1.Category{}
+		if err = rows.Scan(&category.CategoryId, &parent, &category.Name, &category.QuizCount); err != nil {
+			return nil, fmt.Errorf("failed to scan category: %w", err)
+		}
+		byID[category.CategoryId] = category
+		parents[category.CategoryId] = parent
+		order = append(order, category.CategoryId)
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("error iterating categories: %w", rows.Err())
+	}
+
+	resp := &v1.ListCategoriesResponse{}
+	for _, id := range order {
+		category := byID[id]
+		if parent, ok := byID[parents[id]]; ok {
+			parent.Children = append(parent.Children, category)
+		} else {
+			resp.Categories = append(resp.Categories, category)
+		}
+	}
+	for _, category := range resp.Categories {
+		countSubtree(category)
+	}
+	return resp, nil
+}
+
+// countSubtree добавляет к числу квизов категории квизы всех подкатегорий
+func countSubtree(category *v1.Category) int32 {
+	for _, child := range category.Children {
+		category.QuizCount += countSubtree(child)
+	}
+	return category.QuizCount
+}
+
+// GetPopularTags возвращает самые частые теги опубликованных публичных квизов для облака тегов
+func (r *Repository) GetPopularTags(ctx context.Context, limit int32, category string) (*v1.GetPopularTagsResponse, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT t.Tag, COUNT(*)
+		FROM quiz_tags t JOIN quizzes q ON q.Quiz_ID = t.Quiz_ID
+		WHERE q.Status = $1 AND q.Visibility = $4 AND ($3 = '' OR q.Category_ID IN (`+categoryTree("$3")+`))
+		GROUP BY t.Tag
+		ORDER BY COUNT(*) DESC, t.Tag
+		LIMIT $2`,
+		statusPublished, limit, category, visibilityPublic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+	tags, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*v1.TagCount, error) {
+		tag := &v1.TagCount{}
+		return tag, row.Scan(&tag.Tag, &tag.Count)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan tag: %w", err)
+	}
+	return &v1.GetPopularTagsResponse{Tags: tags}, nil
+}
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	v1 "quizzes/pkg/api/v1"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// dailyPlayable - условие на квиз q, который может быть квизом дня:
+// опубликованный публичный квиз с вопросами
+const dailyPlayable = `q.Status = 'published' AND q.Visibility = 'public'
+	AND EXISTS (SELECT 1 FROM questions qs WHERE qs.Quiz_ID = q.Quiz_ID AND qs.Revision = q.Published_revision)`
+
+// formatDay возвращает день как YYYY-MM-DD, пустую строку для nil
+func formatDay(day *time.Time) string {
+	if day == nil {
+		return ""
+	}
+	return day.Format(time.DateOnly)
+}
+
+// DailyQuiz возвращает квиз дня и способ его выбора. Если квиз еще не выбран
+// или больше не может быть квизом дня, возвращаются пустые строки.
+func (r *Repository) DailyQuiz(ctx context.Context, day time.Time) (string, string, error) {
+	var quizID, strategy string
+	err := r.pool.QueryRow(ctx,
+		`SELECT d.Quiz_ID, d.Strategy FROM daily_quizzes d JOIN quizzes q ON q.Quiz_ID = d.Quiz_ID
+		WHERE d.Day = $1 AND `+dailyPlayable,
+		day).Scan(&quizID, &strategy)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return "", "", nil
+		}
+		return "", "", fmt.Errorf("failed to get daily quiz: %w", err)
+	}
+	return quizID, strategy, nil
+}
+
+// DailyCandidates возвращает квизы, которые могут стать квизом дня day.
+// Квизы, уже бывшие квизом дня за последние repeatAfter дней, пропускаются.
+func (r *Repository) DailyCandidates(ctx context.Context, day time.Time, repeatAfter int) ([]string, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT q.Quiz_ID FROM quizzes q WHERE `+dailyPlayable+`
+		AND NOT EXISTS (SELECT 1 FROM daily_quizzes d
+			WHERE d.Quiz_ID = q.Quiz_ID AND d.Day < $1 AND d.Day > $1::date - $2::int)
+		ORDER BY q.Quiz_ID`,
+		day, repeatAfter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list daily candidates: %w", err)
+	}
+	defer rows.Close()
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan daily candidate: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// SetDailyQuiz запоминает квиз дня. Выбор другого экземпляра сервиса не перезаписывается,
+// если его квиз все еще можно играть; в этом случае возвращается он.
+func (r *Repository) SetDailyQuiz(ctx context.Context, day time.Time, quizID string, strategy string) (string, string, error) {
+	err := r.pool.QueryRow(ctx,
+		`INSERT INTO daily_quizzes (Day, Quiz_ID, Strategy) VALUES ($1, $2, $3)
+		ON CONFLICT (Day) DO UPDATE SET Quiz_ID = EXCLUDED.Quiz_ID, Strategy = EXCLUDED.Strategy, Picked_at = CURRENT_TIMESTAMP
+		WHERE NOT EXISTS (SELECT 1 FROM quizzes q WHERE q.Quiz_ID = daily_quizzes.Quiz_ID AND `+dailyPlayable+`)
+		RETURNING Quiz_ID, Strategy`,
+		day, quizID, strategy).Scan(&quizID, &strategy)
+	if err == pgx.ErrNoRows {
+		return r.DailyQuiz(ctx, day)
+	}
+	if err != nil {
+		return "", "", fmt.Errorf("failed to set daily quiz: %w", err)
+	}
+	return quizID, strategy, nil
+}
+
+// QuizSummary возвращает краткое описание опубликованного квиза
+func (r *Repository) QuizSummary(ctx context.Context, quizID string) (*v1.QuizSummary, error) {
+	quiz := &v1.QuizSummary{}
+	err := r.pool.QueryRow(ctx,
+		`SELECT `+summaryColumns+` FROM quizzes q WHERE q.Quiz_ID = $1`,
+		quizID).Scan(summaryFields(quiz)...)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "quiz not found")
+		}
+		return nil, fmt.Errorf("failed to get quiz summary: %w", err)
+	}
+	return quiz, nil
+}
+
+// DailySession возвращает ежедневную сессию игрока за день day и закончена ли она.
+// Если игрок еще не играл, возвращается пустая строка.
+func (r *Repository) DailySession(ctx context.Context, day time.Time, player string) (string, bool, error) {
+	var sessionID string
+	var finished bool
+	err := r.pool.QueryRow(ctx,
+		"SELECT Session_ID, Finished_at IS NOT NULL FROM sessions WHERE Player = $1 AND Daily_date = $2",
+		player, day).Scan(&sessionID, &finished)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("failed to get daily session: %w", err)
+	}
+	return sessionID, finished, nil
+}
+
+// StartDailySession создает единственную ежедневную сессию игрока за день day
+func (r *Repository) StartDailySession(ctx context.Context, quizID string, player string, day time.Time) (*v1.StartSessionResponse, error) {
+	return r.startSession(ctx, quizID, player, &day)
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"math/rand/v2"
+
+	v1 "quizzes/pkg/api/v1"
+)
+
+// newSeed выбирает зерно выборки для новой сессии
+func newSeed() int64 {
+	return rand.Int64()
+}
+
+// drawQuestions выбирает вопросы сессии и их порядок по зерну: из каждого пула берется
+// Draw случайных вопросов, вопросы без пула попадают всегда. Одно и то же зерно на одной
+// ревизии всегда дает одну и ту же выборку, поэтому сессию можно воспроизвести.
+// Ответы перемешиваются на месте, у вопросов остаются их идентификаторы.
+func drawQuestions(quiz *v1.GetQuizResponse, seed int64) []*v1.CreateQuestion {
+	rng := rand.New(rand.NewPCG(uint64(seed), 0))
+	shuffle := quiz.GetShuffle()
+
+	byPool := make(map[string][]int)
+	for i, q := range quiz.Question {
+		byPool[q.Pool] = append(byPool[q.Pool], i)
+	}
+	drawn := make([]bool, len(quiz.Question))
+	for _, i := range byPool[""] {
+		drawn[i] = true
+	}
+	pooled := make(map[string]bool, len(shuffle.GetPools()))
+	for _, pool := range shuffle.GetPools() {
+		pooled[pool.Name] = true
+		candidates := byPool[pool.Name]
+		for n, j := range rng.Perm(len(candidates)) {
+			if n >= int(pool.Draw) {
+				break
+			}
+			drawn[candidates[j]] = true
+		}
+	}
+
+	var questions []*v1.CreateQuestion
+	for i, q := range quiz.Question {
+		// Вопрос из неизвестного пула задается всегда, как вопрос без пула
+		if drawn[i] || !pooled[q.Pool] {
+			questions = append(questions, q)
+		}
+	}
+	if shuffle.GetShuffleQuestions() {
+		rng.Shuffle(len(questions), func(i, j int) { questions[i], questions[j] = questions[j], questions[i] })
+	}
+	if shuffle.GetShuffleAnswers() {
+		for _, q := range questions {
+			// У "верно/неверно" привычный порядок ответов, порядок ответов в ordering скрывается отдельно
+			switch q.QuestionType {
+			case v1.QuestionType_QUESTION_TYPE_TRUE_FALSE, v1.QuestionType_QUESTION_TYPE_ORDERING:
+				continue
+			}
+			rng.Shuffle(len(q.Answer), func(i, j int) { q.Answer[i], q.Answer[j] = q.Answer[j], q.Answer[i] })
+		}
+	}
+	return questions
+}
+
+// PlayOrder перемешивает по зерну то, что при игре нельзя показывать в исходном порядке:
+// ответы на упорядочивание и варианты сопоставления, которые собираются в MatchOptions.
+// Используется отдельный поток того же генератора, что и в drawQuestions, поэтому порядок
+// не зависит от выборки вопросов, а одно и то же зерно всегда дает один и тот же порядок.
+func PlayOrder(questions []*v1.CreateQuestion, seed int64) {
+	rng := rand.New(rand.NewPCG(uint64(seed), 1))
+	for _, q := range questions {
+		switch q.QuestionType {
+		case v1.QuestionType_QUESTION_TYPE_ORDERING:
+			rng.Shuffle(len(q.Answer), func(i, j int) { q.Answer[i], q.Answer[j] = q.Answer[j], q.Answer[i] })
+		case v1.QuestionType_QUESTION_TYPE_MATCHING:
+			q.MatchOptions = make([]string, 0, len(q.Answer))
+			for _, a := range q.Answer {
+				q.MatchOptions = append(q.MatchOptions, a.MatchText)
+			}
+			rng.Shuffle(len(q.MatchOptions), func(i, j int) {
+				q.MatchOptions[i], q.MatchOptions[j] = q.MatchOptions[j], q.MatchOptions[i]
+			})
+		}
+	}
+}
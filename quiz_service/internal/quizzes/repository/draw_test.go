@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"fmt"
+	"testing"
+
+	v1 "quizzes/pkg/api/v1"
+)
+
+func poolQuiz() *v1.GetQuizResponse {
+	quiz := &v1.GetQuizResponse{Shuffle: &v1.QuizShuffle{
+		ShuffleQuestions: true,
+		ShuffleAnswers:   true,
+		Pools:            []*v1.QuestionPool{{Name: "easy", Draw: 2}, {Name: "hard", Draw: 1}},
+	}}
+	add := func(id, pool string) {
+		quiz.Question = append(quiz.Question, &v1.CreateQuestion{
+			QuestionId: id,
+			Pool:       pool,
+			Answer:     []*v1.CreateAnswer{{AnswerId: id + "-a"}, {AnswerId: id + "-b"}, {AnswerId: id + "-c"}},
+		})
+	}
+	add("always", "")
+	for i := 0; i < 5; i++ {
+		add(fmt.Sprintf("easy%d", i), "easy")
+		add(fmt.Sprintf("hard%d", i), "hard")
+	}
+	return quiz
+}
+
+func ids(questions []*v1.CreateQuestion) []string {
+	var out []string
+	for _, q := range questions {
+		out = append(out, q.QuestionId)
+		for _, a := range q.Answer {
+			out = append(out, a.AnswerId)
+		}
+	}
+	return out
+}
+
+func TestDrawQuestionsPools(t *testing.T) {
+	for seed := int64(0); seed < 50; seed++ {
+		drawn := drawQuestions(poolQuiz(), seed)
+		count := map[string]int{}
+		for _, q := range drawn {
+			count[q.Pool]++
+		}
+		if count[""] != 1 || count["easy"] != 2 || count["hard"] != 1 {
+			t.Fatalf("seed %d: got %v questions per pool", seed, count)
+		}
+	}
+}
+
+func TestDrawQuestionsReproducible(t *testing.T) {
+	first := fmt.Sprint(ids(drawQuestions(poolQuiz(), 42)))
+	if again := fmt.Sprint(ids(drawQuestions(poolQuiz(), 42))); again != first {
+		t.Fatalf("same seed gave different draws:\n%s\n%s", first, again)
+	}
+	differs := false
+	for seed := int64(0); seed < 10 && !differs; seed++ {
+		differs = fmt.Sprint(ids(drawQuestions(poolQuiz(), seed))) != first
+	}
+	if !differs {
+		t.Fatal("different seeds always gave the same draw")
+	}
+}
+
+func TestDrawQuestionsKeepsOrder(t *testing.T) {
+	quiz := poolQuiz()
+	quiz.Shuffle = nil
+	drawn := drawQuestions(quiz, 7)
+	if len(drawn) != len(quiz.Question) {
+		t.Fatalf("got %d questions, want all %d", len(drawn), len(quiz.Question))
+	}
+	for i, q := range drawn {
+		if q != quiz.Question[i] || q.Answer[0].AnswerId != q.QuestionId+"-a" {
+			t.Fatalf("question %d was moved or shuffled", i)
+		}
+	}
+}
+
+func playQuestions() []*v1.CreateQuestion {
+	var ordering, matching []*v1.CreateAnswer
+	for i := 0; i < 8; i++ {
+		ordering = append(ordering, &v1.CreateAnswer{AnswerId: fmt.Sprintf("step%d", i)})
+		matching = append(matching, &v1.CreateAnswer{AnswerId: fmt.Sprintf("left%d", i), MatchText: fmt.Sprintf("right%d", i)})
+	}
+	return []*v1.CreateQuestion{
+		{QuestionId: "ordering", QuestionType: v1.QuestionType_QUESTION_TYPE_ORDERING, Answer: ordering},
+		{QuestionId: "matching", QuestionType: v1.QuestionType_QUESTION_TYPE_MATCHING, Answer: matching},
+	}
+}
+
+func playOrder(seed int64) string {
+	questions := playQuestions()
+	PlayOrder(questions, seed)
+	return fmt.Sprint(ids(questions), questions[1].MatchOptions)
+}
+
+func TestPlayOrderReproducible(t *testing.T) {
+	first := playOrder(42)
+	if again := playOrder(42); again != first {
+		t.Fatalf("same seed gave different orders:\n%s\n%s", first, again)
+	}
+	differs := false
+	for seed := int64(0); seed < 10 && !differs; seed++ {
+		differs = playOrder(seed) != first
+	}
+	if !differs {
+		t.Fatal("different seeds always gave the same order")
+	}
+
+	questions := playQuestions()
+	PlayOrder(questions, 42)
+	// Пары сопоставления остаются на ответах, перемешиваются только варианты
+	for i, a := range questions[1].Answer {
+		if a.MatchText != fmt.Sprintf("right%d", i) {
+			t.Fatalf("answer %s lost its match text %q", a.AnswerId, a.MatchText)
+		}
+	}
+	if len(questions[1].MatchOptions) != len(questions[1].Answer) {
+		t.Fatalf("got %d match options, want %d", len(questions[1].MatchOptions), len(questions[1].Answer))
+	}
+}
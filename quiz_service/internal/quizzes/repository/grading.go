@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	v1 "quizzes/pkg/api/v1"
+)
+
+// Значения колонки questions.Question_type
+var questionTypes = map[v1.QuestionType]string{
+	v1.QuestionType_QUESTION_TYPE_SINGLE_CHOICE: "single_choice",
+	v1.QuestionType_QUESTION_TYPE_MULTI_SELECT:  "multi_select",
+	v1.QuestionType_QUESTION_TYPE_TRUE_FALSE:    "true_false",
+	v1.QuestionType_QUESTION_TYPE_FREE_TEXT:     "free_text",
+	v1.QuestionType_QUESTION_TYPE_NUMERIC:       "numeric",
+	v1.QuestionType_QUESTION_TYPE_ORDERING:      "ordering",
+	v1.QuestionType_QUESTION_TYPE_MATCHING:      "matching",
+}
+
+func questionTypeFromDB(name string) v1.QuestionType {
+	for t, n := range questionTypes {
+		if n == name {
+			return t
+		}
+	}
+	return v1.QuestionType_QUESTION_TYPE_SINGLE_CHOICE
+}
+
+// Grade оценивает ответ на вопрос долей от 0 до 1.
+// Ответы вопроса должны идти в порядке Position.
+func Grade(q *v1.CreateQuestion, req *v1.SubmitAnswerRequest) float32 {
+	switch q.QuestionType {
+	case v1.QuestionType_QUESTION_TYPE_MULTI_SELECT:
+		return gradeMultiSelect(q.Answer, req.AnswerId)
+	case v1.QuestionType_QUESTION_TYPE_FREE_TEXT:
+		return gradeFreeText(q.Answer, req.Text)
+	case v1.QuestionType_QUESTION_TYPE_NUMERIC:
+		return gradeNumeric(q, req.Text)
+	case v1.QuestionType_QUESTION_TYPE_ORDERING:
+		return gradeOrdering(q.Answer, req.AnswerId)
+	case v1.QuestionType_QUESTION_TYPE_MATCHING:
+		return gradeMatching(q.Answer, req.Matches)
+	default:
+		var correct []string
+		for _, a := range q.Answer {
+			if a.IsCorrect {
+				correct = append(correct, a.AnswerId)
+			}
+		}
+		if sameAnswers(req.AnswerId, correct) {
+			return 1
+		}
+		return 0
+	}
+}
+
+// gradeMultiSelect дает балл за каждый выбранный правильный ответ и снимает за каждый
+// неправильный, чтобы выбор всех вариантов сразу не приносил очков
+func gradeMultiSelect(answers []*v1.CreateAnswer, selected []string) float32 {
+	chosen := make(map[string]struct{}, len(selected))
+	for _, id := range selected {
+		chosen[id] = struct{}{}
+	}
+	var correct, hits, misses int
+	for _, a := range answers {
+		_, ok := chosen[a.AnswerId]
+		switch {
+		case a.IsCorrect:
+			correct++
+			if ok {
+				hits++
+			}
+		case ok:
+			misses++
+		}
+	}
+	if correct == 0 || hits <= misses {
+		return 0
+	}
+	return float32(hits-misses) / float32(correct)
+}
+
+// normalizeText приводит ответ к нижнему регистру и схлопывает пробелы
+func normalizeText(text string) string {
+	return strings.Join(strings.Fields(strings.ToLower(text)), " ")
+}
+
+func gradeFreeText(answers []*v1.CreateAnswer, text string) float32 {
+	given := normalizeText(text)
+	if given == "" {
+		return 0
+	}
+	for _, a := range answers {
+		if normalizeText(a.AnswerText) == given {
+			return 1
+		}
+	}
+	return 0
+}
+
+func gradeNumeric(q *v1.CreateQuestion, text string) float32 {
+	if q.NumericAnswer == nil {
+		return 0
+	}
+	// Десятичную запятую тоже принимаем
+	value, err := strconv.ParseFloat(strings.ReplaceAll(strings.TrimSpace(text), ",", "."), 64)
+	if err != nil {
+		return 0
+	}
+	if math.Abs(value-q.GetNumericAnswer()) <= q.NumericTolerance {
+		return 1
+	}
+	return 0
+}
+
+// gradeOrdering дает долю ответов, поставленных на свое место
+func gradeOrdering(answers []*v1.CreateAnswer, order []string) float32 {
+	if len(answers) == 0 {
+		return 0
+	}
+	var placed int
+	for i, a := range answers {
+		if i < len(order) && order[i] == a.AnswerId {
+			placed++
+		}
+	}
+	return float32(placed) / float32(len(answers))
+}
+
+// gradeMatching дает долю правильно сопоставленных пар
+func gradeMatching(answers []*v1.CreateAnswer, matches []*v1.AnswerMatch) float32 {
+	if len(answers) == 0 {
+		return 0
+	}
+	chosen := make(map[string]string, len(matches))
+	for _, m := range matches {
+		chosen[m.AnswerId] = normalizeText(m.MatchText)
+	}
+	var matched int
+	for _, a := range answers {
+		if got, ok := chosen[a.AnswerId]; ok && got == normalizeText(a.MatchText) {
+			matched++
+		}
+	}
+	return float32(matched) / float32(len(answers))
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	v1 "quizzes/pkg/api/v1"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TakeHint открывает игроку следующую подсказку вопроса. Подсказки открываются по порядку
+// и только до ответа на вопрос, их стоимость вычитается из балла при ответе.
+// Брать подсказки может только игрок, начавший сессию.
+func (r *Repository) TakeHint(ctx context.Context, player string, sessionID string, questionID string) (*v1.TakeHintResponse, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	session, err := lockSession(ctx, tx, sessionID, player)
+	if err != nil {
+		return nil, err
+	}
+	if session.finished {
+		return nil, status.Error(codes.FailedPrecondition, "session is already finished")
+	}
+	if !session.drew(questionID) {
+		return nil, status.Error(codes.NotFound, "question not found in session")
+	}
+	var answered bool
+	err = tx.QueryRow(ctx,
+		"SELECT EXISTS(SELECT 1 FROM session_answers WHERE Session_ID = $1 AND Question_ID = $2)",
+		sessionID, questionID).Scan(&answered)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session answer: %w", err)
+	}
+	if answered {
+		return nil, status.Error(codes.FailedPrecondition, "question is already answered")
+	}
+
+	var taken, total int32
+	err = tx.QueryRow(ctx,
+		`SELECT (SELECT COUNT(*) FROM session_hints WHERE Session_ID = $1 AND Question_ID = $2),
+			(SELECT COUNT(*) FROM question_hints h JOIN questions q ON q.Question_ID = h.Question_ID
+			WHERE h.Question_ID = $2 AND q.Quiz_ID = $3 AND q.Revision = $4)`,
+		sessionID, questionID, session.quizID, session.revision).Scan(&taken, &total)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count hints: %w", err)
+	}
+	if taken >= total {
+		return nil, status.Error(codes.FailedPrecondition, "no hints left")
+	}
+
+	hint := &v1.Hint{}
+	err = tx.QueryRow(ctx,
+		"SELECT Hint_text, Cost FROM question_hints WHERE Question_ID = $1 AND Position = $2",
+		questionID, taken).Scan(&hint.Text, &hint.Cost)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hint: %w", err)
+	}
+	_, err = tx.Exec(ctx,
+		"INSERT INTO session_hints (Session_ID, Question_ID, Position, Cost) VALUES ($1, $2, $3, $4)",
+		sessionID, questionID, taken, hint.Cost)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert session hint: %w", err)
+	}
+	penalty, err := hintPenalty(ctx, tx, sessionID, questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return &v1.TakeHintResponse{Hint: hint, Index: taken, Remaining: total - taken - 1, TotalCost: penalty}, nil
+}
+
+// hintPenalty возвращает долю баллов за вопрос, потраченную на подсказки, не больше всего вопроса
+func hintPenalty(ctx context.Context, tx pgx.Tx, sessionID string, questionID string) (float32, error) {
+	var penalty float32
+	err := tx.QueryRow(ctx,
+		"SELECT COALESCE(SUM(Cost), 0) FROM session_hints WHERE Session_ID = $1 AND Question_ID = $2",
+		sessionID, questionID).Scan(&penalty)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get hint penalty: %w", err)
+	}
+	return min(penalty, 1), nil
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	v1 "quizzes/pkg/api/v1"
+	"quizzes/pkg/media"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SaveImage сохраняет описание загруженной картинки
+func (r *Repository) SaveImage(ctx context.Context, imageID string, owner string, size int, img *media.Image) error {
+	_, err := r.pool.Exec(ctx,
+		`INSERT INTO images (Image_ID, Owner, Content_type, Size, Width, Height, Thumbnail_type)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		imageID, owner, img.ContentType, size, img.Width, img.Height, img.ThumbnailType)
+	if err != nil {
+		return fmt.Errorf("failed to insert image: %w", err)
+	}
+	return nil
+}
+
+// GetImageType возвращает тип содержимого картинки или ее превью
+func (r *Repository) GetImageType(ctx context.Context, imageID string, thumbnail bool) (string, error) {
+	var contentType, thumbnailType string
+	err := r.pool.QueryRow(ctx,
+		"SELECT Content_type, Thumbnail_type FROM images WHERE Image_ID = $1", imageID,
+	).Scan(&contentType, &thumbnailType)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", status.Error(codes.NotFound, "image not found")
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get image: %w", err)
+	}
+	if thumbnail {
+		return thumbnailType, nil
+	}
+	return contentType, nil
+}
+
+// checkImages не дает сослаться на незагруженные картинки. Ссылки, которые уже
+// есть в ревизиях квизов quizIDs, допускаются: так старые квизы с внешними URL остаются редактируемыми.
+func checkImages(ctx context.Context, tx pgx.Tx, quizIDs []string, image_id *string, questions []*v1.CreateQuestion) error {
+	ids := make([]string, 0, len(questions)+1)
+	if image_id != nil && *image_id != "" {
+		ids = append(ids, *image_id)
+	}
+	for _, q := range questions {
+		if q.ImageId != nil && *q.ImageId != "" {
+			ids = append(ids, *q.ImageId)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	var unknown string
+	err := tx.QueryRow(ctx,
+		`SELECT id FROM unnest($1::text[]) AS id
+		WHERE NOT EXISTS (SELECT 1 FROM images WHERE Image_ID = id)
+			AND NOT EXISTS (SELECT 1 FROM quiz_revisions WHERE Quiz_ID = ANY($2) AND Image_ID = id)
+			AND NOT EXISTS (SELECT 1 FROM questions WHERE Quiz_ID = ANY($2) AND Image_ID = id)
+		LIMIT 1`,
+		ids, quizIDs).Scan(&unknown)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check images: %w", err)
+	}
+	return status.Errorf(codes.InvalidArgument, "unknown image_id %q", unknown)
+}
@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	v1 "quizzes/pkg/api/v1"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Значения колонки quizzes.Status
+const (
+	statusDraft     = "draft"
+	statusPublished = "published"
+	statusArchived  = "archived"
+)
+
+var quizStatuses = map[string]v1.QuizStatus{
+	statusDraft:     v1.QuizStatus_QUIZ_STATUS_DRAFT,
+	statusPublished: v1.QuizStatus_QUIZ_STATUS_PUBLISHED,
+	statusArchived:  v1.QuizStatus_QUIZ_STATUS_ARCHIVED,
+}
+
+// SaveDraft сохраняет черновик. Пустой quizID создает новый квиз в статусе черновика,
+// иначе к квизу добавляется неопубликованная ревизия.
+func (r *Repository) SaveDraft(
+	ctx context.Context,
+	user string,
+	quizID string,
+	name string,
+	image_id *string,
+	description *string,
+	questions []*v1.CreateQuestion,
+	tags []string,
+	category string,
+	visibility v1.QuizVisibility,
+	timing *v1.QuizTiming,
+	shuffle *v1.QuizShuffle,
+) (string, int32, error) {
+	if quizID == "" {
+		quizID, _, err := r.createQuiz(ctx, statusDraft, "", name, user, image_id, description, questions, tags, category, visibility, timing, shuffle)
+		return quizID, 1, err
+	}
+	revision, err := r.saveRevision(ctx, user, quizID, false, name, image_id, description, questions, tags, category, visibility, timing, shuffle)
+	return quizID, revision, err
+}
+
+// PublishQuiz делает ревизию доступной игрокам. Публикуется только последняя ревизия,
+// иначе автор мог бы опубликовать не то, что проверил. Вместе с ревизией квиз получает
+// ее теги, категорию и видимость; у ревизий, сохраненных без них, остаются прежние.
+func (r *Repository) PublishQuiz(ctx context.Context, user string, quizID string, revision int32) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	state, err := lockQuiz(ctx, tx, quizID, user)
+	if err != nil {
+		return err
+	}
+	if state.revision != revision {
+		return status.Error(codes.Aborted, "quiz was changed while publishing")
+	}
+	_, err = tx.Exec(ctx,
+		`UPDATE quizzes q SET Status = $3, Published_revision = r.Revision,
+			Name = r.Name, Image_ID = r.Image_ID, Description = r.Description,
+			Visibility = COALESCE(r.Visibility, q.Visibility),
+			Category_ID = CASE WHEN r.Visibility IS NULL THEN q.Category_ID ELSE r.Category_ID END
+		FROM quiz_revisions r
+		WHERE q.Quiz_ID = $1 AND r.Quiz_ID = q.Quiz_ID AND r.Revision = $2`,
+		quizID, revision, statusPublished)
+	if err != nil {
+		return fmt.Errorf("failed to publish quiz: %w", err)
+	}
+	var stored bool
+	var tags []string
+	err = tx.QueryRow(ctx,
+		"SELECT Visibility IS NOT NULL, COALESCE(Tags, '{}') FROM quiz_revisions WHERE Quiz_ID = $1 AND Revision = $2",
+		quizID, revision).Scan(&stored, &tags)
+	if err != nil {
+		return fmt.Errorf("failed to get revision tags: %w", err)
+	}
+	if stored {
+		if err = setTags(ctx, tx, quizID, tags); err != nil {
+			return err
+		}
+	}
+	_, err = tx.Exec(ctx,
+		"UPDATE quiz_revisions SET Published_at = COALESCE(Published_at, CURRENT_TIMESTAMP) WHERE Quiz_ID = $1 AND Revision = $2",
+		quizID, revision)
+	if err != nil {
+		return fmt.Errorf("failed to publish revision: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// ArchiveQuiz убирает квиз из списков и запрещает новые игры.
+// Сыгранные сессии и сам квиз по ссылке остаются доступны.
+func (r *Repository) ArchiveQuiz(ctx context.Context, user string, quizID string) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	state, err := lockQuiz(ctx, tx, quizID, user)
+	if err != nil {
+		return err
+	}
+	if state.status == statusDraft {
+		return status.Error(codes.FailedPrecondition, "draft cannot be archived, delete it instead")
+	}
+	_, err = tx.Exec(ctx, "UPDATE quizzes SET Status = $2 WHERE Quiz_ID = $1", quizID, statusArchived)
+	if err != nil {
+		return fmt.Errorf("failed to archive quiz: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// CloneQuiz копирует доступную user ревизию квиза со всеми вопросами и ответами
+// в новый черновик user. Копия помнит, с какого квиза она сделана.
+// В копии чужого квиза правильные ответы не сохраняются, их заполняет автор копии.
+func (r *Repository) CloneQuiz(ctx context.Context, user string, quizID string) (string, string, error) {
+	source, err := r.GetVisibleQuiz(ctx, quizID, 0, user)
+	if err != nil {
+		return "", "", err
+	}
+	if source.Author != user {
+		stripAnswerKeys(source.Question)
+	}
+	return r.createQuiz(ctx, statusDraft, source.ShortID, source.Name, user, source.ImageId, source.Description,
+		source.Question, source.Tags, source.CategoryId, source.Visibility, source.Timing, source.Shuffle)
+}
+
+// stripAnswerKeys убирает из вопросов все, по чему можно узнать правильный ответ:
+// отметки верных ответов, отзывы, числовой ответ, пояснения и тексты подсказок.
+// Ответы на упорядочивание сортируются по тексту, пары сопоставления стираются.
+func stripAnswerKeys(questions []*v1.CreateQuestion) {
+	for _, q := range questions {
+		for _, a := range q.Answer {
+			a.IsCorrect = false
+			a.Feedback = ""
+		}
+		q.NumericAnswer = nil
+		q.NumericTolerance = 0
+		q.Explanation = nil
+		for _, h := range q.Hints {
+			h.Text = ""
+		}
+		switch q.QuestionType {
+		case v1.QuestionType_QUESTION_TYPE_FREE_TEXT:
+			q.Answer = nil
+		case v1.QuestionType_QUESTION_TYPE_ORDERING:
+			slices.SortFunc(q.Answer, func(a, b *v1.CreateAnswer) int { return strings.Compare(a.AnswerText, b.AnswerText) })
+		case v1.QuestionType_QUESTION_TYPE_MATCHING:
+			for _, a := range q.Answer {
+				a.MatchText = ""
+			}
+		}
+	}
+}
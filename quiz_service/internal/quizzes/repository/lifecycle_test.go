@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	v1 "quizzes/pkg/api/v1"
+
+	"github.com/pashagolub/pgxmock/v2"
+)
+
+// expectLockedQuiz ждет блокировку опубликованного квиза author с текущей ревизией revision
+func expectLockedQuiz(mock pgxmock.PgxPoolIface, revision int32) {
+	mock.ExpectBegin()
+	mock.ExpectQuery("FROM quizzes WHERE Quiz_ID").WithArgs("QUIZ1").WillReturnRows(
+		mock.NewRows([]string{"author", "status", "revision", "published_revision"}).
+			AddRow("author", statusPublished, revision, int32(3)))
+}
+
+func TestStripAnswerKeys(t *testing.T) {
+	explanation, answer := "because", 42.0
+	questions := []*v1.CreateQuestion{
+		{
+			QuestionType: v1.QuestionType_QUESTION_TYPE_SINGLE_CHOICE,
+			Explanation:  &explanation,
+			Hints:        []*v1.Hint{{Text: "think", Cost: 0.5}},
+			Answer:       []*v1.CreateAnswer{{AnswerText: "yes", IsCorrect: true, Feedback: "right"}, {AnswerText: "no"}},
+		},
+		{QuestionType: v1.QuestionType_QUESTION_TYPE_NUMERIC, NumericAnswer: &answer, NumericTolerance: 1},
+		{QuestionType: v1.QuestionType_QUESTION_TYPE_FREE_TEXT, Answer: []*v1.CreateAnswer{{AnswerText: "paris"}}},
+		{QuestionType: v1.QuestionType_QUESTION_TYPE_ORDERING, Answer: []*v1.CreateAnswer{{AnswerText: "c"}, {AnswerText: "a"}, {AnswerText: "b"}}},
+		{QuestionType: v1.QuestionType_QUESTION_TYPE_MATCHING, Answer: []*v1.CreateAnswer{{AnswerText: "fr", MatchText: "paris"}}},
+	}
+	stripAnswerKeys(questions)
+
+	choice := questions[0]
+	if choice.Answer[0].IsCorrect || choice.Answer[0].Feedback != "" || choice.Explanation != nil ||
+		choice.Hints[0].Text != "" || choice.Hints[0].Cost != 0.5 {
+		t.Fatalf("choice question keeps its key: %v", choice)
+	}
+	if q := questions[1]; q.NumericAnswer != nil || q.NumericTolerance != 0 {
+		t.Fatalf("numeric question keeps its key: %v", q)
+	}
+	if q := questions[2]; q.Answer != nil {
+		t.Fatalf("free text question keeps accepted answers: %v", q)
+	}
+	if q := questions[3]; q.Answer[0].AnswerText != "a" || q.Answer[1].AnswerText != "b" || q.Answer[2].AnswerText != "c" {
+		t.Fatalf("ordering question keeps its order: %v", q)
+	}
+	if q := questions[4]; q.Answer[0].MatchText != "" {
+		t.Fatalf("matching question keeps its pairs: %v", q)
+	}
+}
+
+func TestSaveDraftKeepsLiveSettings(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.Close()
+	expectLockedQuiz(mock, 3)
+	// Черновик опубликованного квиза двигает только номер ревизии, теги и видимость остаются в ревизии
+	mock.ExpectExec("UPDATE quizzes SET Revision").WithArgs("QUIZ1", int32(4)).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectExec("INSERT INTO quiz_revisions").WithArgs("QUIZ1", int32(4), "quiz", pgxmock.AnyArg(), pgxmock.AnyArg(),
+		int32(0), false, false, false, false, []string{"new"}, "science", visibilityPrivate).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	_, revision, err := (&Repository{pool: mock}).SaveDraft(context.Background(), "author", "QUIZ1", "quiz", nil, nil,
+		nil, []string{"New"}, "science", v1.QuizVisibility_QUIZ_VISIBILITY_PRIVATE, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if revision != 4 {
+		t.Fatalf("got revision %d, want 4", revision)
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPublishQuizAppliesRevisionSettings(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.Close()
+	expectLockedQuiz(mock, 4)
+	mock.ExpectExec("Visibility = COALESCE\\(r.Visibility").WithArgs("QUIZ1", int32(4), statusPublished).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectQuery("SELECT Visibility IS NOT NULL").WithArgs("QUIZ1", int32(4)).
+		WillReturnRows(mock.NewRows([]string{"stored", "tags"}).AddRow(true, []string{"new"}))
+	mock.ExpectExec("DELETE FROM quiz_tags").WithArgs("QUIZ1").WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mock.ExpectExec("INSERT INTO quiz_tags").WithArgs("QUIZ1", []string{"new"}).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec("SET Published_at").WithArgs("QUIZ1", int32(4)).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectCommit()
+
+	if err = (&Repository{pool: mock}).PublishQuiz(context.Background(), "author", "QUIZ1", 4); err != nil {
+		t.Fatal(err)
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "quizzes/pkg/api/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// summaryColumns - колонки краткого описания квиза q в его опубликованной ревизии,
+// читаются через summaryFields
+const summaryColumns = `q.Quiz_ID, q.Name, q.Author, q.Image_ID, q.Description, COALESCE(q.Category_ID, ''),
+	(SELECT COUNT(*) FROM questions qs WHERE qs.Quiz_ID = q.Quiz_ID AND qs.Revision = q.Published_revision),
+	ARRAY(SELECT t.Tag FROM quiz_tags t WHERE t.Quiz_ID = q.Quiz_ID ORDER BY t.Tag)`
+
+func summaryFields(quiz *v1.QuizSummary) []any {
+	return []any{&quiz.ShortID, &quiz.Name, &quiz.Author, &quiz.ImageId, &quiz.Description, &quiz.CategoryId,
+		&quiz.QuestionCount, &quiz.Tags}
+}
+
+// pageToken - позиция последнего квиза страницы для keyset-пагинации.
+// Фильтры запоминаются, чтобы токен нельзя было применить к другой выборке.
+type pageToken struct {
+	Sort      v1.ListSort `json:"s"`
+	Filter    string      `json:"f"`
+	Name      string      `json:"n,omitempty"`
+	CreatedAt time.Time   `json:"c,omitempty"`
+	QuizID    string      `json:"i"`
+}
+
+func listFilter(req *v1.ListAllRequest) string {
+	return strings.Join([]string{req.Author, req.Tag, req.Text, req.CategoryId}, "\x00")
+}
+
+func encodePageToken(token pageToken) string {
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodePageToken(raw string, req *v1.ListAllRequest) (*pageToken, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	var token pageToken
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err == nil {
+		err = json.Unmarshal(data, &token)
+	}
+	if err != nil || token.Sort != req.Sort || token.Filter != listFilter(req) {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+	return &token, nil
+}
+
+// ListAll возвращает страницу опубликованных квизов без вопросов одним запросом
+func (r *Repository) ListAll(ctx context.Context, req *v1.ListAllRequest) (*v1.ListAllResponse, error) {
+	token, err := decodePageToken(req.PageToken, req)
+	if err != nil {
+		return nil, err
+	}
+
+	args := []any{statusPublished}
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+	where := []string{"q.Status = $1", listedFilter("q", arg(r.friendOf(ctx)))}
+	if req.Author != "" {
+		where = append(where, "q.Author = "+arg(req.Author))
+	}
+	if req.Tag != "" {
+		where = append(where, "EXISTS (SELECT 1 FROM quiz_tags t WHERE t.Quiz_ID = q.Quiz_ID AND t.Tag = "+arg(normalizeText(req.Tag))+")")
+	}
+	if req.CategoryId != "" {
+		where = append(where, "q.Category_ID IN ("+categoryTree(arg(req.CategoryId))+")")
+	}
+	if req.Text != "" {
+		pattern := arg("%" + escapeLike(req.Text) + "%")
+		where = append(where, "(q.Name ILIKE "+pattern+" OR q.Description ILIKE "+pattern+")")
+	}
+	order := "q.Created_at DESC, q.Quiz_ID DESC"
+	if req.Sort == v1.ListSort_LIST_SORT_NAME {
+		order = "q.Name, q.Quiz_ID"
+	}
+	if token != nil {
+		if req.Sort == v1.ListSort_LIST_SORT_NAME {
+			where = append(where, "(q.Name, q.Quiz_ID) > ("+arg(token.Name)+", "+arg(token.QuizID)+")")
+		} else {
+			where = append(where, "(q.Created_at, q.Quiz_ID) < ("+arg(token.CreatedAt)+", "+arg(token.QuizID)+")")
+		}
+	}
+	// Лишняя строка показывает, есть ли следующая страница
+	limit := arg(req.PageSize + 1)
+
+	rows, err := r.pool.Query(ctx, fmt.Sprintf(
+		`SELECT %s, q.Created_at
+		FROM quizzes q
+		WHERE %s
+		ORDER BY %s
+		LIMIT %s`,
+		summaryColumns, strings.Join(where, " AND "), order, limit), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list quizzes: %w", err)
+	}
+	defer rows.Close()
+
+	resp := &v1.ListAllResponse{}
+	var last pageToken
+	for rows.Next() {
+		if len(resp.Quizzes) == int(req.PageSize) {
+			resp.NextPageToken = encodePageToken(last)
+			break
+		}
+		quiz := &v1.QuizSummary{}
+		var createdAt time.Time
+		err = rows.Scan(append(summaryFields(quiz), &createdAt)...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan quiz: %w", err)
+		}
+		last = pageToken{Sort: req.Sort, Filter: listFilter(req), Name: quiz.Name, CreatedAt: createdAt, QuizID: quiz.ShortID}
+		resp.Quizzes = append(resp.Quizzes, quiz)
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("error iterating quizzes: %w", rows.Err())
+	}
+	return resp, nil
+}
+
+// escapeLike экранирует спецсимволы шаблона LIKE
+func escapeLike(text string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(text)
+}
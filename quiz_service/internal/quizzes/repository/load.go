@@ -0,0 +1,209 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	v1 "quizzes/pkg/api/v1"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// quizRef указывает на ревизию квиза, 0 означает текущую
+type quizRef struct {
+	id       string
+	revision int32
+}
+
+// loadQuizzes загружает квизы целиком за постоянное число запросов, сколько бы квизов
+// и вопросов ни было: заголовки с тегами одним запросом, вопросы с ответами другим.
+// Результат идет в порядке refs, несуществующие квизы пропускаются.
+func (r *Repository) loadQuizzes(ctx context.Context, refs []quizRef) ([]*v1.GetQuizResponse, error) {
+	byRef, err := r.loadQuizMap(ctx, refs)
+	if err != nil {
+		return nil, err
+	}
+	return pickQuizzes(byRef, refs), nil
+}
+
+// pickQuizzes выбирает загруженные квизы в порядке refs, пропуская незагруженные
+func pickQuizzes(byRef map[quizRef]*v1.GetQuizResponse, refs []quizRef) []*v1.GetQuizResponse {
+	quizzes := make([]*v1.GetQuizResponse, 0, len(refs))
+	for _, ref := range refs {
+		if quiz, ok := byRef[ref]; ok {
+			quizzes = append(quizzes, quiz)
+		}
+	}
+	return quizzes
+}
+
+// loadQuizMap загружает квизы так же, как loadQuizzes, но отдает их по ссылкам.
+// Так один пакет можно разложить на несколько списков. Ни разу не опубликованная ревизия
+// показывает свои теги, категорию и видимость, остальные - действующие у квиза.
+func (r *Repository) loadQuizMap(ctx context.Context, refs []quizRef) (map[quizRef]*v1.GetQuizResponse, error) {
+	if len(refs) == 0 {
+		return nil, nil
+	}
+	ids := make([]string, len(refs))
+	revisions := make([]int32, len(refs))
+	for i, ref := range refs {
+		ids[i], revisions[i] = ref.id, ref.revision
+	}
+
+	rows, err := r.pool.Query(ctx,
+		`SELECT q.Quiz_ID, t.Revision, r.Revision, r.Name, q.Author,
+			r.Image_ID, r.Description, q.Status, q.Published_revision,
+			COALESCE(CASE WHEN p.Pending THEN r.Category_ID ELSE q.Category_ID END, ''),
+			CASE WHEN p.Pending THEN ARRAY(SELECT unnest(r.Tags) ORDER BY 1)
+				ELSE ARRAY(SELECT g.Tag FROM quiz_tags g WHERE g.Quiz_ID = q.Quiz_ID ORDER BY g.Tag) END,
+			COALESCE(q.Forked_from, ''), COALESCE(src.Author, ''),
+			(SELECT count(*)::int FROM quizzes f WHERE f.Forked_from = q.Quiz_ID),
+			CASE WHEN p.Pending THEN r.Visibility ELSE q.Visibility END,
+			r.Time_limit, r.Speed_bonus, r.Shuffle_questions, r.Shuffle_answers,
+			ARRAY(SELECT p.Name FROM question_pools p WHERE p.Quiz_ID = r.Quiz_ID AND p.Revision = r.Revision ORDER BY p.Position),
+			ARRAY(SELECT p.Draw FROM question_pools p WHERE p.Quiz_ID = r.Quiz_ID AND p.Revision = r.Revision ORDER BY p.Position)
+		FROM unnest($1::text[], $2::int[]) AS t(Quiz_ID, Revision)
+		JOIN quizzes q ON q.Quiz_ID = t.Quiz_ID
+		LEFT JOIN quizzes src ON src.Quiz_ID = q.Forked_from
+		JOIN quiz_revisions r ON r.Quiz_ID = q.Quiz_ID AND r.Revision = COALESCE(NULLIF(t.Revision, 0), q.Revision)
+		CROSS JOIN LATERAL (SELECT r.Published_at IS NULL AND r.Visibility IS NOT NULL AS Pending) p`,
+		ids, revisions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quiz: %w", err)
+	}
+	byRef := make(map[quizRef]*v1.GetQuizResponse, len(refs))
+	byRevision := make(map[quizRef]*v1.GetQuizResponse, len(refs))
+	var quizIDs []string
+	var quizRevisions []int32
+	for rows.Next() {
+		var ref quizRef
+		var quizStatus, visibility string
+		var poolNames []string
+		var poolDraws []int32
+		quiz := &v1.GetQuizResponse{Timing: &v1.QuizTiming{}, Shuffle: &v1.QuizShuffle{}}
+		err = rows.Scan(&ref.id, &ref.revision, &quiz.Revision, &quiz.Name, &quiz.Author,
+			&quiz.ImageId, &quiz.Description, &quizStatus, &quiz.PublishedRevision, &quiz.CategoryId, &quiz.Tags,
+			&quiz.ForkedFrom, &quiz.ForkedFromAuthor, &quiz.ForkCount, &visibility,
+			&quiz.Timing.TimeLimitSeconds, &quiz.Timing.SpeedBonus, &quiz.Shuffle.ShuffleQuestions, &quiz.Shuffle.ShuffleAnswers,
+			&poolNames, &poolDraws)
+		if err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan quiz: %w", err)
+		}
+		quiz.ShortID = ref.id
+		quiz.Status = quizStatuses[quizStatus]
+		quiz.Visibility = visibilityFromDB(visibility)
+		for i, name := range poolNames {
+			quiz.Shuffle.Pools = append(quiz.Shuffle.Pools, &v1.QuestionPool{Name: name, Draw: poolDraws[i]})
+		}
+		// Одна и та же ревизия может быть запрошена и явно, и как текущая
+		resolved := quizRef{id: ref.id, revision: quiz.Revision}
+		if same, ok := byRevision[resolved]; ok {
+			quiz = same
+		} else {
+			byRevision[resolved] = quiz
+			quizIDs = append(quizIDs, ref.id)
+			quizRevisions = append(quizRevisions, quiz.Revision)
+		}
+		byRef[ref] = quiz
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("error iterating quizzes: %w", rows.Err())
+	}
+	if len(quizIDs) == 0 {
+		return nil, nil
+	}
+
+	rows, err = r.pool.Query(ctx,
+		`SELECT qs.Quiz_ID, qs.Revision, qs.Question_ID, qs.Question_text, qs.Image_ID,
+			qs.Question_type, qs.Numeric_answer, qs.Numeric_tolerance, qs.Time_limit, qs.Pool, qs.Explanation,
+			ARRAY(SELECT h.Hint_text FROM question_hints h WHERE h.Question_ID = qs.Question_ID ORDER BY h.Position),
+			ARRAY(SELECT h.Cost FROM question_hints h WHERE h.Question_ID = qs.Question_ID ORDER BY h.Position),
+			a.Answer_ID, a.Answer_text, a.Is_correct, a.Match_text, a.Feedback
+		FROM unnest($1::text[], $2::int[]) AS t(Quiz_ID, Revision)
+		JOIN questions qs ON qs.Quiz_ID = t.Quiz_ID AND qs.Revision = t.Revision
+		LEFT JOIN answers a ON a.Question_ID = qs.Question_ID
+		ORDER BY qs.Quiz_ID, qs.Revision, qs.Position, a.Position`,
+		quizIDs, quizRevisions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get questions: %w", err)
+	}
+	defer rows.Close()
+	var question *v1.CreateQuestion
+	for rows.Next() {
+		var ref quizRef
+		var questionID, questionText, questionType, pool string
+		var imageID, explanation *string
+		var hintTexts []string
+		var hintCosts []float32
+		var numericAnswer *float64
+		var numericTolerance float64
+		var timeLimit int32
+		var answerID, answerText, matchText, feedback *string
+		var isCorrect *bool
+		err = rows.Scan(&ref.id, &ref.revision, &questionID, &questionText, &imageID,
+			&questionType, &numericAnswer, &numericTolerance, &timeLimit, &pool, &explanation,
+			&hintTexts, &hintCosts,
+			&answerID, &answerText, &isCorrect, &matchText, &feedback)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan question: %w", err)
+		}
+		if question == nil || question.QuestionId != questionID {
+			question = &v1.CreateQuestion{
+				QuestionId:       questionID,
+				QuestionText:     questionText,
+				ImageId:          imageID,
+				QuestionType:     questionTypeFromDB(questionType),
+				NumericAnswer:    numericAnswer,
+				NumericTolerance: numericTolerance,
+				TimeLimitSeconds: timeLimit,
+				Pool:             pool,
+				Explanation:      explanation,
+			}
+			for i, text := range hintTexts {
+				question.Hints = append(question.Hints, &v1.Hint{Text: text, Cost: hintCosts[i]})
+			}
+			quiz := byRevision[ref]
+			quiz.Question = append(quiz.Question, question)
+		}
+		// У вопроса без ответов LEFT JOIN дает одну строку с пустым ответом
+		if answerID != nil {
+			question.Answer = append(question.Answer, &v1.CreateAnswer{
+				AnswerId:   *answerID,
+				AnswerText: *answerText,
+				IsCorrect:  *isCorrect,
+				MatchText:  *matchText,
+				Feedback:   *feedback,
+			})
+		}
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("error iterating questions: %w", rows.Err())
+	}
+
+	return byRef, nil
+}
+
+// visibleRefs выбирает ревизии квизов, доступные viewer: автору последние,
+// остальным опубликованные. Черновики и закрытые от viewer чужие квизы пропускаются,
+// friends - авторы, добавившие viewer в друзья.
+func (r *Repository) visibleRefs(ctx context.Context, quizIDs []string, viewer string, friends []string) ([]quizRef, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT q.Quiz_ID, CASE WHEN q.Author = $2 THEN q.Revision ELSE q.Published_revision END
+		FROM unnest($1::text[]) WITH ORDINALITY AS t(Quiz_ID, n)
+		JOIN quizzes q ON q.Quiz_ID = t.Quiz_ID
+		WHERE q.Author = $2 OR q.Published_revision > 0 AND (q.Visibility = $4 OR `+listedFilter("q", "$3")+`)
+		ORDER BY t.n`,
+		quizIDs, viewer, friends, visibilityUnlisted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quizzes: %w", err)
+	}
+	refs, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (quizRef, error) {
+		var ref quizRef
+		return ref, row.Scan(&ref.id, &ref.revision)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan quiz: %w", err)
+	}
+	return refs, nil
+}
@@ -0,0 +1,291 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "quizzes/pkg/authapi/v1"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Число запросов к базе на загрузку дерева квиза и на страницу автора не зависит от размера данных
+const (
+	quizQueries   = 2
+	authorQueries = 2 + quizQueries
+)
+
+// favoritesStub отдает избранное и друзей без обращения к auth_service
+type favoritesStub struct {
+	pb.AuthServiceClient
+	ids []string
+}
+
+func (f favoritesStub) GetFavoriteQuizzes(context.Context, *pb.GetFavoriteQuizzesRequest, ...grpc.CallOption) (*pb.FavoriteQuizzesResponse, error) {
+	return &pb.FavoriteQuizzesResponse{QuizIds: f.ids}, nil
+}
+
+func (f favoritesStub) GetFriendOf(context.Context, *pb.GetFriendOfRequest, ...grpc.CallOption) (*pb.FriendOfResponse, error) {
+	return &pb.FriendOfResponse{}, nil
+}
+
+// countingDB считает запросы, дошедшие до базы
+type countingDB struct {
+	PGDatabase
+	queries int
+}
+
+func (c *countingDB) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	c.queries++
+	return c.PGDatabase.Query(ctx, sql, args...)
+}
+
+func (c *countingDB) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	c.queries++
+	return c.PGDatabase.QueryRow(ctx, sql, args...)
+}
+
+// reportQueries проверяет, что на каждую итерацию ушло ровно want запросов
+func reportQueries(b *testing.B, db *countingDB, want int) {
+	perOp := float64(db.queries) / float64(b.N)
+	if perOp != float64(want) {
+		b.Fatalf("got %.2f queries per op, want %d", perOp, want)
+	}
+	b.ReportMetric(perOp, "queries/op")
+}
+
+func quizRows(mock pgxmock.PgxPoolIface, ids []string, requested int32) *pgxmock.Rows {
+	rows := mock.NewRows([]string{"quiz_id", "requested", "revision", "name", "author",
+		"image_id", "description", "status", "published_revision", "category_id", "tags",
+		"forked_from", "forked_from_author", "fork_count", "visibility", "time_limit", "speed_bonus",
+		"shuffle_questions", "shuffle_answers", "pool_names", "pool_draws"})
+	for _, id := range ids {
+		rows.AddRow(id, requested, int32(1), "quiz "+id, "author", nil, nil, statusPublished, int32(1), "", []string{"tag"}, "", "", int32(0), visibilityPublic, int32(0), false,
+			false, false, []string{}, []int32{})
+	}
+	return rows
+}
+
+func questionRows(mock pgxmock.PgxPoolIface, ids []string, questions int) *pgxmock.Rows {
+	rows := mock.NewRows([]string{"quiz_id", "revision", "question_id", "question_text", "image_id",
+		"question_type", "numeric_answer", "numeric_tolerance", "time_limit", "pool", "explanation",
+		"hint_texts", "hint_costs",
+		"answer_id", "answer_text", "is_correct", "match_text", "feedback"})
+	for _, id := range ids {
+		for q := 0; q < questions; q++ {
+			questionID := fmt.Sprintf("%s-q%d", id, q)
+			for a := 0; a < 4; a++ {
+				answerID, text, isCorrect, matchText := fmt.Sprintf("%s-a%d", questionID, a), "answer", a == 0, ""
+				rows.AddRow(id, int32(1), questionID, "question", nil,
+					"single_choice", nil, float64(0), int32(0), "", nil,
+					[]string{}, []float32{},
+					&answerID, &text, &isCorrect, &matchText, &matchText)
+			}
+		}
+	}
+	return rows
+}
+
+func quizIDs(n int) []string {
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("Q%04d", i)
+	}
+	return ids
+}
+
+func BenchmarkGetQuiz(b *testing.B) {
+	for _, questions := range []int{1, 10, 100} {
+		b.Run(fmt.Sprintf("questions=%d", questions), func(b *testing.B) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				b.Fatal(err)
+			}
+			defer mock.Close()
+			db := &countingDB{PGDatabase: mock}
+			repo := &Repository{pool: db}
+			ids := quizIDs(1)
+
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				mock.ExpectQuery("FROM unnest").WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnRows(quizRows(mock, ids, 0))
+				mock.ExpectQuery("JOIN questions").WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnRows(questionRows(mock, ids, questions))
+				b.StartTimer()
+
+				quiz, err := repo.GetQuiz(context.Background(), ids[0], 0)
+				if err != nil {
+					b.Fatal(err)
+				}
+				if len(quiz.Question) != questions {
+					b.Fatalf("got %d questions, want %d", len(quiz.Question), questions)
+				}
+			}
+			if err = mock.ExpectationsWereMet(); err != nil {
+				b.Fatal(err)
+			}
+			reportQueries(b, db, quizQueries)
+		})
+	}
+}
+
+func BenchmarkGetQuizByAuthor(b *testing.B) {
+	for _, quizzes := range []int{1, 10, 100} {
+		b.Run(fmt.Sprintf("quizzes=%d", quizzes), func(b *testing.B) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				b.Fatal(err)
+			}
+			defer mock.Close()
+			ids := quizIDs(quizzes)
+			favorites := ids[:quizzes/2]
+			db := &countingDB{PGDatabase: mock}
+			repo := &Repository{pool: db, auth: favoritesStub{ids: favorites}}
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token"))
+
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				authorRows := mock.NewRows([]string{"quiz_id", "revision"})
+				for _, id := range ids {
+					authorRows.AddRow(id, int32(1))
+				}
+				favoriteRows := mock.NewRows([]string{"quiz_id", "revision"})
+				for _, id := range favorites {
+					favoriteRows.AddRow(id, int32(1))
+				}
+				loaded := append(append([]string{}, ids...), favorites...)
+				mock.ExpectQuery("FROM quizzes q WHERE Author").WithArgs("author", "author", statusPublished, []string{}).WillReturnRows(authorRows)
+				mock.ExpectQuery("WITH ORDINALITY").WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnRows(favoriteRows)
+				mock.ExpectQuery("FROM unnest").WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnRows(quizRows(mock, loaded, 1))
+				mock.ExpectQuery("JOIN questions").WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnRows(questionRows(mock, ids, 10))
+				b.StartTimer()
+
+				resp, err := repo.GetQuizByAuthor(ctx, "author", "author")
+				if err != nil {
+					b.Fatal(err)
+				}
+				if got := len(resp.AuthorQuizzes[0].Quizzes); got != quizzes {
+					b.Fatalf("got %d quizzes, want %d", got, quizzes)
+				}
+			}
+			if err = mock.ExpectationsWereMet(); err != nil {
+				b.Fatal(err)
+			}
+			reportQueries(b, db, authorQueries)
+		})
+	}
+}
+
+func TestGetVisibleQuizRevisions(t *testing.T) {
+	tests := []struct {
+		name      string
+		viewer    string
+		published bool
+		want      codes.Code
+	}{
+		{"published revision", "player", true, codes.OK},
+		{"saved draft revision", "player", false, codes.NotFound},
+		{"author sees drafts", "author", false, codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer mock.Close()
+			ids := quizIDs(1)
+			// Запрошена вторая ревизия, опубликована сейчас третья
+			rows := quizRows(mock, nil, 2)
+			rows.AddRow(ids[0], int32(2), int32(2), "quiz", "author", nil, nil, statusPublished, int32(3), "", []string{}, "", "", int32(0), visibilityPublic, int32(0), false,
+				false, false, []string{}, []int32{})
+			mock.ExpectQuery("FROM unnest").WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnRows(rows)
+			mock.ExpectQuery("JOIN questions").WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnRows(questionRows(mock, nil, 0))
+			if tt.viewer != "author" {
+				mock.ExpectQuery("FROM quiz_revisions").WithArgs(ids[0], int32(2)).
+					WillReturnRows(mock.NewRows([]string{"published"}).AddRow(tt.published))
+			}
+
+			_, err = (&Repository{pool: mock}).GetVisibleQuiz(context.Background(), ids[0], 2, tt.viewer)
+			if status.Code(err) != tt.want {
+				t.Fatalf("got %v, want %v", err, tt.want)
+			}
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestGetVisibleQuizPendingDraft(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.Close()
+	ids := quizIDs(1)
+	// Последняя ревизия - приватный черновик, опубликованная третья ревизия публичная
+	draft := quizRows(mock, nil, 0)
+	draft.AddRow(ids[0], int32(0), int32(4), "quiz", "author", nil, nil, statusPublished, int32(3), "", []string{}, "", "", int32(0), visibilityPrivate, int32(0), false,
+		false, false, []string{}, []int32{})
+	mock.ExpectQuery("FROM unnest").WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnRows(draft)
+	mock.ExpectQuery("JOIN questions").WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnRows(questionRows(mock, nil, 0))
+	live := quizRows(mock, nil, 3)
+	live.AddRow(ids[0], int32(3), int32(3), "quiz", "author", nil, nil, statusPublished, int32(3), "", []string{}, "", "", int32(0), visibilityPublic, int32(0), false,
+		false, false, []string{}, []int32{})
+	mock.ExpectQuery("FROM unnest").WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnRows(live)
+	mock.ExpectQuery("JOIN questions").WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnRows(questionRows(mock, nil, 0))
+
+	quiz, err := (&Repository{pool: mock}).GetVisibleQuiz(context.Background(), ids[0], 0, "player")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if quiz.Revision != 3 {
+		t.Fatalf("got revision %d, want 3", quiz.Revision)
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetQuizByAuthorMissingQuiz(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.Close()
+	repo := &Repository{pool: mock, auth: favoritesStub{ids: []string{"FAV01"}}}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token"))
+
+	mock.ExpectQuery("FROM quizzes q WHERE Author").WithArgs("author", "author", statusPublished, []string{}).
+		WillReturnRows(mock.NewRows([]string{"quiz_id", "revision"}).AddRow("GONE1", int32(1)).AddRow("MINE1", int32(1)))
+	mock.ExpectQuery("WITH ORDINALITY").WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+		WillReturnRows(mock.NewRows([]string{"quiz_id", "revision"}).AddRow("FAV01", int32(1)))
+	// Квиз GONE1 удалили между запросами
+	mock.ExpectQuery("FROM unnest").WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnRows(quizRows(mock, []string{"MINE1", "FAV01"}, 1))
+	mock.ExpectQuery("JOIN questions").WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnRows(questionRows(mock, nil, 0))
+
+	resp, err := repo.GetQuizByAuthor(ctx, "author", "author")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got [][]string
+	for _, list := range resp.AuthorQuizzes {
+		var ids []string
+		for _, quiz := range list.Quizzes {
+			ids = append(ids, quiz.ShortID)
+		}
+		got = append(got, ids)
+	}
+	if fmt.Sprint(got) != "[[MINE1] [FAV01]]" {
+		t.Fatalf("got author and favorite quizzes %v, want [[MINE1] [FAV01]]", got)
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -9,31 +9,40 @@ import (
 	v1 "quizzes/pkg/api/v1"
 	"quizzes/pkg/logger"
 	"quizzes/pkg/postgres"
-	"strings"
 
 	pb "quizzes/pkg/authapi/v1"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/pgconn"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
+// PGDatabase - часть pgxpool.Pool, которой пользуется репозиторий; в тестах ее подменяет pgxmock
+type PGDatabase interface {
+	Begin(context.Context) (pgx.Tx, error)
+	Exec(context.Context, string, ...any) (pgconn.CommandTag, error)
+	Query(context.Context, string, ...any) (pgx.Rows, error)
+	QueryRow(context.Context, string, ...any) pgx.Row
+	Close()
+}
+
 type Repository struct {
-	pool *pgxpool.Pool
+	pool     PGDatabase
+	authConn *grpc.ClientConn
+	auth     pb.AuthServiceClient
 }
 type IDGenerator struct {
-	pool    *pgxpool.Pool
+	pool    PGDatabase
 	charset string
 }
 
 // NewIDGenerator создает новый генератор
-func NewIDGenerator(pool *pgxpool.Pool) *IDGenerator {
+func NewIDGenerator(pool PGDatabase) *IDGenerator {
 	return &IDGenerator{
 		pool:    pool,
 		charset: "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789",
@@ -101,12 +110,24 @@ func NewRepository(ctx context.Context, config *config.Config) *Repository {
 		logger.GetLoggerFromCtx(ctx).Info(ctx, "connected to postgres")
 		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprint("pinging postgres: ", pg.Ping(ctx)))
 	}
-	return &Repository{
-		pool: pg,
+	conn, err := grpc.NewClient(config.AuthAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Error(ctx, fmt.Sprint("failed to connect to auth", zap.Error(err)))
+	}
+	repo := &Repository{
+		pool:     pg,
+		authConn: conn,
+	}
+	if conn != nil {
+		repo.auth = pb.NewAuthServiceClient(conn)
 	}
+	return repo
 }
 func (r *Repository) CloseConn() {
 	r.pool.Close()
+	if r.authConn != nil {
+		r.authConn.Close()
+	}
 }
 func (r *Repository) CreateQuiz(
 	ctx context.Context,
@@ -115,7 +136,36 @@ func (r *Repository) CreateQuiz(
 	image_id *string,
 	description *string,
 	questions []*v1.CreateQuestion,
+	tags []string,
+	category string,
+	visibility v1.QuizVisibility,
+	timing *v1.QuizTiming,
+	shuffle *v1.QuizShuffle,
 ) (string, string, error) {
+	return r.createQuiz(ctx, statusPublished, "", name, author, image_id, description, questions, tags, category, visibility, timing, shuffle)
+}
+
+// createQuiz создает квиз с первой ревизией в указанном статусе.
+// forkedFrom - квиз, копией которого является новый, или пустая строка.
+func (r *Repository) createQuiz(
+	ctx context.Context,
+	quizStatus string,
+	forkedFrom string,
+	name string,
+	author string,
+	image_id *string,
+	description *string,
+	questions []*v1.CreateQuestion,
+	tags []string,
+	category string,
+	visibility v1.QuizVisibility,
+	timing *v1.QuizTiming,
+	shuffle *v1.QuizShuffle,
+) (string, string, error) {
+	var published int32
+	if quizStatus == statusPublished {
+		published = 1
+	}
 	tx, err := r.pool.Begin(ctx)
 	if err != nil {
 		return "", "", fmt.Errorf("failed to begin transaction: %w", err)
@@ -129,200 +179,361 @@ func (r *Repository) CreateQuiz(
 	uuID := uuid.New().String()
 
 	_, err = tx.Exec(ctx,
-		"INSERT INTO quizzes (Quiz_ID, Name, Author, Image_ID, Description, UUID) VALUES ($1, $2, $3, $4, $5, $6)",
-		quizID, name, author, &image_id, &description, uuID)
+		`INSERT INTO quizzes (Quiz_ID, Name, Author, Image_ID, Description, UUID, Revision, Status, Published_revision,
+			Forked_from, Visibility)
+		VALUES ($1, $2, $3, $4, $5, $6, 1, $7, $8, NULLIF($9, ''), $10)`,
+		quizID, name, author, &image_id, &description, uuID, quizStatus, published, forkedFrom, visibilities[visibility])
 	if err != nil {
 		return "", "", fmt.Errorf("failed to insert quiz: %w", err)
 	}
-	for _, q := range questions {
-		questionID := uuid.New().String()
+	// Копия может ссылаться на картинки исходного квиза
+	if err = checkImages(ctx, tx, []string{quizID, forkedFrom}, image_id, questions); err != nil {
+		return "", "", err
+	}
+	if err = insertRevision(ctx, tx, quizID, 1, published == 1, name, image_id, description, questions, tags, category, visibility, timing, shuffle); err != nil {
+		return "", "", err
+	}
+	if err = setTags(ctx, tx, quizID, tags); err != nil {
+		return "", "", err
+	}
+	if err = setCategory(ctx, tx, quizID, category); err != nil {
+		return "", "", err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return quizID, uuID, nil
+}
+
+// insertRevision сохраняет неизменяемую ревизию квиза: описание и дерево вопросов с ответами.
+// published отмечает ревизию опубликованной, только такие ревизии видны не автору.
+// Теги, категория и видимость запоминаются в ревизии и переходят к квизу при ее публикации.
+func insertRevision(
+	ctx context.Context,
+	tx pgx.Tx,
+	quizID string,
+	revision int32,
+	published bool,
+	name string,
+	image_id *string,
+	description *string,
+	questions []*v1.CreateQuestion,
+	tags []string,
+	category string,
+	visibility v1.QuizVisibility,
+	timing *v1.QuizTiming,
+	shuffle *v1.QuizShuffle,
+) error {
+	_, err := tx.Exec(ctx,
+		`INSERT INTO quiz_revisions (Quiz_ID, Revision, Name, Image_ID, Description, Time_limit, Speed_bonus,
+			Shuffle_questions, Shuffle_answers, Published_at, Tags, Category_ID, Visibility)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, CASE WHEN $10 THEN CURRENT_TIMESTAMP END, $11, NULLIF($12, ''), $13)`,
+		quizID, revision, name, &image_id, &description, timing.GetTimeLimitSeconds(), timing.GetSpeedBonus(),
+		shuffle.GetShuffleQuestions(), shuffle.GetShuffleAnswers(), published,
+		normalizeTags(tags), category, visibilities[visibility])
+	if unknownCategory(err) {
+		return status.Error(codes.InvalidArgument, "unknown category_id")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to insert revision: %w", err)
+	}
+	for i, pool := range shuffle.GetPools() {
 		_, err = tx.Exec(ctx,
-			"INSERT INTO questions (Question_ID, Quiz_ID, Question_text, Image_ID) VALUES ($1, $2, $3, $4)",
-			questionID, quizID, q.QuestionText, &q.ImageId)
+			"INSERT INTO question_pools (Quiz_ID, Revision, Name, Draw, Position) VALUES ($1, $2, $3, $4, $5)",
+			quizID, revision, pool.Name, pool.Draw, i)
+		if err != nil {
+			return fmt.Errorf("failed to insert question pool: %w", err)
+		}
+	}
+	for i, q := range questions {
+		questionID := uuid.New().String()
+		_, err := tx.Exec(ctx,
+			`INSERT INTO questions (Question_ID, Quiz_ID, Revision, Question_text, Image_ID, Position,
+				Question_type, Numeric_answer, Numeric_tolerance, Time_limit, Pool, Explanation)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+			questionID, quizID, revision, q.QuestionText, &q.ImageId, i,
+			questionTypes[q.QuestionType], q.NumericAnswer, q.NumericTolerance, q.TimeLimitSeconds, q.Pool, q.Explanation)
 		if err != nil {
-			return "", "", fmt.Errorf("failed to insert question: %w", err)
+			return fmt.Errorf("failed to insert question: %w", err)
+		}
+		for j, h := range q.Hints {
+			_, err = tx.Exec(ctx,
+				"INSERT INTO question_hints (Question_ID, Position, Hint_text, Cost) VALUES ($1, $2, $3, $4)",
+				questionID, j, h.Text, h.Cost)
+			if err != nil {
+				return fmt.Errorf("failed to insert hint: %w", err)
+			}
 		}
 
-		for _, a := range q.Answer {
+		for j, a := range q.Answer {
 			answerID := uuid.New().String()
 			_, err = tx.Exec(ctx,
-				"INSERT INTO answers (Answer_ID, Question_ID, Answer_text, Is_correct) VALUES ($1, $2, $3, $4)",
-				answerID, questionID, a.AnswerText, a.IsCorrect)
+				`INSERT INTO answers (Answer_ID, Question_ID, Answer_text, Is_correct, Position, Match_text, Feedback)
+				VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+				answerID, questionID, a.AnswerText, a.IsCorrect, j, a.MatchText, a.Feedback)
 			if err != nil {
-				return "", "", fmt.Errorf("failed to insert answer: %w", err)
+				return fmt.Errorf("failed to insert answer: %w", err)
 			}
 		}
 	}
+	return nil
+}
 
-	err = tx.Commit(ctx)
+// UpdateQuiz сохраняет новую ревизию квиза одной транзакцией. У опубликованного квиза
+// она сразу становится опубликованной, у черновика остается черновиком.
+// Старые ревизии не меняются, на них продолжают ссылаться сыгранные сессии.
+// Менять квиз может только его автор.
+func (r *Repository) UpdateQuiz(
+	ctx context.Context,
+	user string,
+	quizID string,
+	name string,
+	image_id *string,
+	description *string,
+	questions []*v1.CreateQuestion,
+	tags []string,
+	category string,
+	visibility v1.QuizVisibility,
+	timing *v1.QuizTiming,
+	shuffle *v1.QuizShuffle,
+) (int32, error) {
+	return r.saveRevision(ctx, user, quizID, true, name, image_id, description, questions, tags, category, visibility, timing, shuffle)
+}
+
+// saveRevision добавляет квизу новую ревизию. При publish ревизия опубликованного квиза
+// становится доступной игрокам, иначе она ждет вызова PublishQuiz.
+func (r *Repository) saveRevision(
+	ctx context.Context,
+	user string,
+	quizID string,
+	publish bool,
+	name string,
+	image_id *string,
+	description *string,
+	questions []*v1.CreateQuestion,
+	tags []string,
+	category string,
+	visibility v1.QuizVisibility,
+	timing *v1.QuizTiming,
+	shuffle *v1.QuizShuffle,
+) (int32, error) {
+	tx, err := r.pool.Begin(ctx)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to commit transaction: %w", err)
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer tx.Rollback(ctx)
 
-	return quizID, uuID, nil
+	state, err := lockQuiz(ctx, tx, quizID, user)
+	if err != nil {
+		return 0, err
+	}
+	if err = checkImages(ctx, tx, []string{quizID}, image_id, questions); err != nil {
+		return 0, err
+	}
+	revision := state.revision + 1
+	live := publish && state.status == statusPublished
+	// Поля в quizzes описывают опубликованную ревизию, пока квиз ни разу не публиковался - последнюю.
+	// Черновая ревизия опубликованного квиза не меняет ни его описание, ни теги, категорию и видимость.
+	apply := live || state.published == 0
+	if apply {
+		published := state.published
+		if live {
+			published = revision
+		}
+		_, err = tx.Exec(ctx,
+			`UPDATE quizzes SET Name = $2, Image_ID = $3, Description = $4, Revision = $5, Published_revision = $6, Visibility = $7
+			WHERE Quiz_ID = $1`,
+			quizID, name, &image_id, &description, revision, published, visibilities[visibility])
+	} else {
+		_, err = tx.Exec(ctx, "UPDATE quizzes SET Revision = $2 WHERE Quiz_ID = $1", quizID, revision)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to update quiz: %w", err)
+	}
+	if err = insertRevision(ctx, tx, quizID, revision, live, name, image_id, description, questions, tags, category, visibility, timing, shuffle); err != nil {
+		return 0, err
+	}
+	if apply {
+		if err = setTags(ctx, tx, quizID, tags); err != nil {
+			return 0, err
+		}
+		if err = setCategory(ctx, tx, quizID, category); err != nil {
+			return 0, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return revision, nil
 }
 
-func (r *Repository) GetQuiz(
-	ctx context.Context,
-	quizID string,
-) (*v1.GetQuizResponse, error) {
-	var name, author, image_id, description string
+// DeleteQuiz удаляет квиз вместе с вопросами и ответами (ON DELETE CASCADE).
+// Удалить квиз может только его автор.
+func (r *Repository) DeleteQuiz(ctx context.Context, user string, quizID string) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
 
-	err := r.pool.QueryRow(ctx,
-		"SELECT Name, Author, Image_ID, Description FROM quizzes WHERE Quiz_ID = $1",
-		quizID).Scan(&name, &author, &image_id, &description)
+	if err = checkAuthor(ctx, tx, quizID, user); err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, "DELETE FROM quizzes WHERE Quiz_ID = $1", quizID)
+	if err != nil {
+		return fmt.Errorf("failed to delete quiz: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// checkAuthor блокирует квиз до конца транзакции и проверяет, что user его автор
+func checkAuthor(ctx context.Context, tx pgx.Tx, quizID string, user string) error {
+	_, err := lockQuiz(ctx, tx, quizID, user)
+	return err
+}
+
+// quizState описывает состояние жизненного цикла квиза
+type quizState struct {
+	status    string
+	revision  int32
+	published int32
+}
+
+// lockQuiz блокирует квиз до конца транзакции, проверяет, что user его автор,
+// и возвращает текущий статус и ревизии
+func lockQuiz(ctx context.Context, tx pgx.Tx, quizID string, user string) (quizState, error) {
+	var author string
+	var state quizState
+	err := tx.QueryRow(ctx,
+		"SELECT Author, Status, Revision, Published_revision FROM quizzes WHERE Quiz_ID = $1 FOR UPDATE",
+		quizID).Scan(&author, &state.status, &state.revision, &state.published)
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("quiz not found")
+			return state, status.Error(codes.NotFound, "quiz not found")
 		}
-		return nil, fmt.Errorf("failed to get quiz: %w", err)
+		return state, fmt.Errorf("failed to get quiz: %w", err)
 	}
+	if author != user {
+		return state, status.Error(codes.PermissionDenied, "only the author can modify the quiz")
+	}
+	return state, nil
+}
 
-	rows, err := r.pool.Query(ctx,
-		"SELECT Question_ID, Question_text, Image_ID FROM questions WHERE Quiz_ID = $1",
-		quizID)
+// GetQuiz возвращает квиз в указанной ревизии, 0 означает текущую
+func (r *Repository) GetQuiz(
+	ctx context.Context,
+	quizID string,
+	revision int32,
+) (*v1.GetQuizResponse, error) {
+	quizzes, err := r.loadQuizzes(ctx, []quizRef{{id: quizID, revision: revision}})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get questions: %w", err)
+		return nil, err
+	}
+	if len(quizzes) == 0 {
+		return nil, status.Error(codes.NotFound, "quiz not found")
 	}
-	defer rows.Close()
+	return quizzes[0], nil
+}
 
-	var questions []*v1.CreateQuestion
-	for rows.Next() {
-		var questionID, questionText, imageID string
-		err = rows.Scan(&questionID, &questionText, &imageID)
+// GetVisibleQuiz возвращает квиз так, как его видит viewer: автору доступны все ревизии,
+// остальным только когда-либо опубликованные. 0 означает последнюю доступную ревизию.
+func (r *Repository) GetVisibleQuiz(
+	ctx context.Context,
+	quizID string,
+	revision int32,
+	viewer string,
+) (*v1.GetQuizResponse, error) {
+	quiz, err := r.GetQuiz(ctx, quizID, revision)
+	if err != nil || viewer != "" && quiz.Author == viewer {
+		return quiz, err
+	}
+	if quiz.PublishedRevision == 0 {
+		return nil, status.Error(codes.NotFound, "quiz not found")
+	}
+	if revision == 0 && quiz.Revision != quiz.PublishedRevision {
+		quiz, err = r.GetQuiz(ctx, quizID, quiz.PublishedRevision)
 		if err != nil {
-			return nil, fmt.Errorf("failed to scan question: %w", err)
+			return nil, err
 		}
-
-		answerRows, err := r.pool.Query(ctx,
-			"SELECT Answer_text, Is_correct FROM answers WHERE Question_ID = $1",
-			questionID)
+	} else if quiz.Revision != quiz.PublishedRevision {
+		// Промежуточные ревизии черновика сохранялись, но игрокам не показывались
+		var published bool
+		err = r.pool.QueryRow(ctx,
+			"SELECT Published_at IS NOT NULL FROM quiz_revisions WHERE Quiz_ID = $1 AND Revision = $2",
+			quizID, quiz.Revision).Scan(&published)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get answers: %w", err)
+			return nil, fmt.Errorf("failed to get revision: %w", err)
 		}
-		defer answerRows.Close()
-
-		var answers []*v1.CreateAnswer
-		for answerRows.Next() {
-			var answerText string
-			var isCorrect bool
-			err = answerRows.Scan(&answerText, &isCorrect)
-			if err != nil {
-				return nil, fmt.Errorf("failed to scan answer: %w", err)
-			}
-			answers = append(answers, &v1.CreateAnswer{
-				AnswerText: answerText,
-				IsCorrect:  isCorrect,
-			})
+		if !published {
+			return nil, status.Error(codes.NotFound, "quiz not found")
 		}
-		if answerRows.Err() != nil {
-			return nil, fmt.Errorf("error iterating answers: %w", answerRows.Err())
-		}
-
-		questions = append(questions, &v1.CreateQuestion{
-			QuestionText: questionText,
-			ImageId:      &imageID,
-			Answer:       answers,
-		})
-	}
-	if rows.Err() != nil {
-		return nil, fmt.Errorf("error iterating questions: %w", rows.Err())
-	}
-	return &v1.GetQuizResponse{
-		ShortID:     quizID,
-		Name:        name,
-		Author:      author,
-		ImageId:     &image_id,
-		Description: &description,
-		Question:    questions,
-	}, nil
+	}
+	// Видимость проверяется по опубликованной ревизии, черновые настройки автора игроков не касаются
+	if !r.canView(ctx, quiz.Author, quiz.Visibility, viewer) {
+		return nil, status.Error(codes.NotFound, "quiz not found")
+	}
+	return quiz, nil
 }
 
+// GetQuizByAuthor возвращает квизы автора и избранное вызывающего.
+// Черновики и архив автора видны только ему самому.
 func (r *Repository) GetQuizByAuthor(
 	ctx context.Context,
 	author string,
+	viewer string,
 ) (*v1.GetQuizByAuthorResponse, error) {
 
+	friends := r.friendOf(ctx)
+	// На странице автора чужим видны его публичные квизы и, для друзей, квизы для друзей
 	rows, err := r.pool.Query(ctx,
-		"SELECT Quiz_ID, Name, Image_ID, Description FROM quizzes WHERE Author = $1", author)
+		`SELECT Quiz_ID, CASE WHEN Author = $2 THEN Revision ELSE Published_revision END
+		FROM quizzes q WHERE Author = $1 AND (Author = $2 OR Status = $3 AND `+listedFilter("q", "$4")+`)
+		ORDER BY Created_at DESC, Quiz_ID`,
+		author, viewer, statusPublished, friends)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get questions: %w", err)
 	}
-	defer rows.Close()
-	var quizzesbyauthor []*v1.GetQuizResponse
-	for rows.Next() {
-		var quiz_ids, name, image_id, description string
-		err = rows.Scan(&quiz_ids, &name, &image_id, &description)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan quiz: %w", err)
-		}
-
-		quiz, err := r.GetQuiz(ctx, quiz_ids)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get quiz: %w", err)
-		}
-		quizzesbyauthor = append(quizzesbyauthor, quiz)
-	}
-	if rows.Err() != nil {
-		return nil, fmt.Errorf("error iterating questions: %w", rows.Err())
-	}
-	author_quizzes := &v1.GetQuizzes{Quizzes: quizzesbyauthor}
-	conn, err := grpc.NewClient("auth_service:50052", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	authorRefs, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (quizRef, error) {
+		var ref quizRef
+		return ref, row.Scan(&ref.id, &ref.revision)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to auth: %w", err)
-	}
-	defer conn.Close()
-	client := pb.NewAuthServiceClient(conn)
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return nil, status.Error(codes.Unauthenticated, "metadata is not provided")
-	}
-
-	authHeaders := md.Get("authorization")
-	if len(authHeaders) == 0 {
-		return nil, status.Error(codes.Unauthenticated, "authorization token is not provided")
+		return nil, fmt.Errorf("failed to scan quiz: %w", err)
 	}
 
-	token := strings.TrimPrefix(authHeaders[0], "Bearer ")
-	if token == "" {
-		return nil, status.Error(codes.Unauthenticated, "invalid auth token format")
+	token, err := bearerToken(ctx)
+	if err != nil {
+		return nil, err
 	}
-	meta := metadata.Pairs("authorization", "Bearer "+token)
-	ctx = metadata.NewOutgoingContext(context.Background(), meta)
+	ctx = withBearerToken(ctx, token)
 	a := &pb.GetFavoriteQuizzesRequest{}
-	var quizbyfav []*v1.GetQuizResponse
-	response, err := client.GetFavoriteQuizzes(ctx, a)
+	response, err := r.auth.GetFavoriteQuizzes(ctx, a)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get quiz: %w", err)
 	}
-	for _, i := range response.QuizIds {
-		quiz, err := r.GetQuiz(ctx, i)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get quiz: %w", err)
-		}
-		quizbyfav = append(quizbyfav, quiz)
+	favRefs, err := r.visibleRefs(ctx, response.QuizIds, viewer, friends)
+	if err != nil {
+		return nil, err
 	}
-	favouritequizzes := &v1.GetQuizzes{Quizzes: quizbyfav}
+
+	// Квизы автора и избранное загружаются одним пакетом и раскладываются по ссылкам:
+	// удаленный между запросами квиз просто пропадает из своего списка
+	loaded, err := r.loadQuizMap(ctx, append(authorRefs, favRefs...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quiz: %w", err)
+	}
+	author_quizzes := &v1.GetQuizzes{Quizzes: pickQuizzes(loaded, authorRefs)}
+	favouritequizzes := &v1.GetQuizzes{Quizzes: pickQuizzes(loaded, favRefs)}
 	var res []*v1.GetQuizzes
 	res = append(res, author_quizzes)
 	res = append(res, favouritequizzes)
 	return &v1.GetQuizByAuthorResponse{AuthorQuizzes: res}, nil
 }
-func (r *Repository) ListAll(ctx context.Context) (*v1.ListAllResponse, error) {
-	rows, err := r.pool.Query(ctx, `SELECT Quiz_ID FROM quizzes`)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get ids: %w", err)
-	}
-	defer rows.Close()
-	var quizzes []*v1.GetQuizResponse
-	for rows.Next() {
-		var quizID string
-		err = rows.Scan(&quizID)
-		quiz, err := r.GetQuiz(ctx, quizID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get quiz: %w", err)
-		}
-		quizzes = append(quizzes, quiz)
-	}
-	return &v1.ListAllResponse{Quizzes: quizzes}, nil
-}
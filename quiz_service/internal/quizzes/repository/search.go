@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	v1 "quizzes/pkg/api/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// searchQuery разбирает $1 и русским, и английским стеммером, так как контент смешанный.
+// Поисковые векторы в таблицах тоже содержат лексемы обоих языков.
+const searchQuery = `WITH query AS (
+	SELECT websearch_to_tsquery('russian', $1) || websearch_to_tsquery('english', $1) AS q
+)`
+
+// Не больше стольких вопросов с подсветкой на один квиз
+const maxQuestionHighlights = 3
+
+// searchToken - смещение следующей страницы поиска. Ранг не уникален,
+// поэтому поиск листается по смещению, а не по ключу.
+type searchToken struct {
+	Query  string `json:"q"`
+	Offset int    `json:"o"`
+}
+
+// headline подсвечивает найденные слова в text тем стеммером, который их нашел
+func headline(text string) string {
+	ru := fmt.Sprintf("ts_headline('russian', %s, query.q, 'MaxWords=20, MinWords=5')", text)
+	en := fmt.Sprintf("ts_headline('english', %s, query.q, 'MaxWords=20, MinWords=5')", text)
+	return fmt.Sprintf("CASE WHEN strpos(%s, '<b>') > 0 THEN %s ELSE %s END", ru, ru, en)
+}
+
+// SearchQuizzes ищет опубликованные квизы по названию, описанию, вопросам и тегам.
+// Ранг квиза складывается из рангов всех совпадений.
+func (r *Repository) SearchQuizzes(ctx context.Context, req *v1.SearchQuizzesRequest) (*v1.SearchQuizzesResponse, error) {
+	var offset int
+	if req.PageToken != "" {
+		var token searchToken
+		data, err := base64.RawURLEncoding.DecodeString(req.PageToken)
+		if err == nil {
+			err = json.Unmarshal(data, &token)
+		}
+		if err != nil || token.Query != req.Query || token.Offset < 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		offset = token.Offset
+	}
+
+	rows, err := r.pool.Query(ctx, searchQuery+`,
+		matches AS (
+			SELECT q.Quiz_ID, ts_rank(q.Search_vector, query.q) AS rank
+			FROM quizzes q, query WHERE q.Search_vector @@ query.q
+			UNION ALL
+			SELECT qs.Quiz_ID, ts_rank(qs.Search_vector, query.q)
+			FROM questions qs JOIN quizzes q ON q.Quiz_ID = qs.Quiz_ID AND qs.Revision = q.Published_revision, query
+			WHERE qs.Search_vector @@ query.q
+			UNION ALL
+			SELECT t.Quiz_ID, ts_rank(t.Search_vector, query.q)
+			FROM quiz_tags t, query WHERE t.Search_vector @@ query.q
+		)
+		SELECT `+summaryColumns+`, SUM(m.rank)::real AS rank
+		FROM matches m JOIN quizzes q ON q.Quiz_ID = m.Quiz_ID
+		WHERE q.Status = $2 AND `+listedFilter("q", "$5")+`
+		GROUP BY q.Quiz_ID
+		ORDER BY rank DESC, q.Quiz_ID
+		LIMIT $3 OFFSET $4`,
+		req.Query, statusPublished, req.PageSize+1, offset, r.friendOf(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to search quizzes: %w", err)
+	}
+	defer rows.Close()
+
+	resp := &v1.SearchQuizzesResponse{}
+	byID := make(map[string]*v1.SearchResult)
+	var ids []string
+	for rows.Next() {
+		if len(resp.Results) == int(req.PageSize) {
+			data, _ := json.Marshal(searchToken{Query: req.Query, Offset: offset + len(resp.Results)})
+			resp.NextPageToken = base64.RawURLEncoding.EncodeToString(data)
+			break
+		}
+		result := &v1.SearchResult{Quiz: &v1.QuizSummary{}}
+		if err = rows.Scan(append(summaryFields(result.Quiz), &result.Rank)...); err != nil {
+			return nil, fmt.Errorf("failed to scan quiz: %w", err)
+		}
+		resp.Results = append(resp.Results, result)
+		byID[result.Quiz.ShortID] = result
+		ids = append(ids, result.Quiz.ShortID)
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("error iterating quizzes: %w", rows.Err())
+	}
+	rows.Close()
+	if len(ids) == 0 {
+		return resp, nil
+	}
+
+	// Подсветка считается только для квизов текущей страницы
+	rows, err = r.pool.Query(ctx, searchQuery+`
+		SELECT Quiz_ID, field, snippet FROM (
+			SELECT q.Quiz_ID, 1 AS ord, 'name' AS field, `+headline("q.Name")+` AS snippet
+			FROM quizzes q, query WHERE q.Quiz_ID = ANY($2)
+			UNION ALL
+			SELECT q.Quiz_ID, 2, 'description', `+headline("q.Description")+`
+			FROM quizzes q, query WHERE q.Quiz_ID = ANY($2) AND q.Description IS NOT NULL
+			UNION ALL
+			SELECT qs.Quiz_ID, 3, 'question', `+headline("qs.Question_text")+`
+			FROM questions qs JOIN quizzes q ON q.Quiz_ID = qs.Quiz_ID AND qs.Revision = q.Published_revision, query
+			WHERE q.Quiz_ID = ANY($2) AND qs.Search_vector @@ query.q
+			UNION ALL
+			SELECT t.Quiz_ID, 4, 'tag', `+headline("t.Tag")+`
+			FROM quiz_tags t, query WHERE t.Quiz_ID = ANY($2) AND t.Search_vector @@ query.q
+		) h
+		WHERE strpos(snippet, '<b>') > 0
+		ORDER BY Quiz_ID, ord`,
+		req.Query, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get highlights: %w", err)
+	}
+	defer rows.Close()
+	questions := make(map[string]int)
+	for rows.Next() {
+		var quizID string
+		highlight := &v1.SearchHighlight{}
+		if err = rows.Scan(&quizID, &highlight.Field, &highlight.Snippet); err != nil {
+			return nil, fmt.Errorf("failed to scan highlight: %w", err)
+		}
+		if highlight.Field == "question" {
+			if questions[quizID] == maxQuestionHighlights {
+				continue
+			}
+			questions[quizID]++
+		}
+		highlight.Snippet = strings.TrimSpace(highlight.Snippet)
+		byID[quizID].Highlights = append(byID[quizID].Highlights, highlight)
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("error iterating highlights: %w", rows.Err())
+	}
+	return resp, nil
+}
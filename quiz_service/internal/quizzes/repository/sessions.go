@@ -0,0 +1,418 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	v1 "quizzes/pkg/api/v1"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StartSession создает игровую сессию по опубликованной ревизии квиза. Квиз возвращается целиком,
+// скрывать правильные ответы должен вызывающий код. С момента создания сессии идут ограничения времени.
+// Вопросы выбираются и перемешиваются по случайному зерну, которое сохраняется вместе с выборкой.
+func (r *Repository) StartSession(ctx context.Context, quizID string, player string) (*v1.StartSessionResponse, error) {
+	return r.startSession(ctx, quizID, player, nil)
+}
+
+// startSession создает сессию, у ежедневной сессии day - день квиза дня
+func (r *Repository) startSession(ctx context.Context, quizID string, player string, day *time.Time) (*v1.StartSessionResponse, error) {
+	var quizStatus, author, visibility string
+	var revision int32
+	err := r.pool.QueryRow(ctx,
+		"SELECT Status, Published_revision, Author, Visibility FROM quizzes WHERE Quiz_ID = $1",
+		quizID).Scan(&quizStatus, &revision, &author, &visibility)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "quiz not found")
+		}
+		return nil, fmt.Errorf("failed to get quiz: %w", err)
+	}
+	if !r.canView(ctx, author, visibilityFromDB(visibility), player) {
+		return nil, status.Error(codes.NotFound, "quiz not found")
+	}
+	if quizStatus != statusPublished {
+		return nil, status.Errorf(codes.FailedPrecondition, "quiz is %s", quizStatus)
+	}
+	quiz, err := r.GetQuiz(ctx, quizID, revision)
+	if err != nil {
+		return nil, err
+	}
+	seed := newSeed()
+	quiz.Question = drawQuestions(quiz, seed)
+	questionIDs := make([]string, len(quiz.Question))
+	for i, q := range quiz.Question {
+		questionIDs[i] = q.QuestionId
+	}
+	sessionID := uuid.New().String()
+	var startedAt time.Time
+	err = r.pool.QueryRow(ctx,
+		`INSERT INTO sessions (Session_ID, Quiz_ID, Revision, Player, Seed, Question_IDs, Daily_date) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING Started_at`,
+		sessionID, quizID, quiz.Revision, player, seed, questionIDs, day).Scan(&startedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" && day != nil {
+			return nil, status.Error(codes.AlreadyExists, "quiz of the day is already played")
+		}
+		return nil, fmt.Errorf("failed to insert session: %w", err)
+	}
+	return &v1.StartSessionResponse{
+		SessionId: sessionID,
+		Quiz:      quiz,
+		StartedAt: startedAt.Format(time.RFC3339Nano),
+		Seed:      seed,
+	}, nil
+}
+
+// SubmitAnswer оценивает ответ на вопрос и сохраняет его в сессии.
+// Ответ на каждый вопрос принимается только один раз. Опоздавший ответ отклоняется,
+// но запоминается с нулевым баллом, чтобы время следующего вопроса считалось от него.
+// Взятые подсказки уменьшают балл за вопрос, после ответа игрок получает пояснения.
+// Отвечать может только игрок, начавший сессию.
+func (r *Repository) SubmitAnswer(ctx context.Context, player string, req *v1.SubmitAnswerRequest) (*v1.SubmitAnswerResponse, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	session, err := lockSession(ctx, tx, req.SessionId, player)
+	if err != nil {
+		return nil, err
+	}
+	if session.finished {
+		return nil, status.Error(codes.FailedPrecondition, "session is already finished")
+	}
+	if !session.drew(req.QuestionId) {
+		return nil, status.Error(codes.NotFound, "question not found in session")
+	}
+
+	question, err := getQuestion(ctx, tx, session.quizID, session.revision, req.QuestionId)
+	if err != nil {
+		return nil, err
+	}
+	clock, err := sessionClock(ctx, tx, req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	clock.questionLimit = question.TimeLimitSeconds
+	late := clock.late()
+
+	penalty, err := hintPenalty(ctx, tx, req.SessionId, req.QuestionId)
+	if err != nil {
+		return nil, err
+	}
+
+	credit := Grade(question, req)
+	if late != nil {
+		credit = 0
+	}
+	isCorrect := credit == 1
+	credit = max(0, credit-penalty)
+	bonus := clock.bonus(credit)
+
+	matches := make([]string, len(req.Matches))
+	for i, m := range req.Matches {
+		matches[i] = m.AnswerId + "=" + m.MatchText
+	}
+	tag, err := tx.Exec(ctx,
+		`INSERT INTO session_answers (Session_ID, Question_ID, Answer_IDs, Answer_text, Matches, Is_correct, Credit,
+			Submitted_at, Elapsed_ms, Bonus, Hint_penalty)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, now(), $8, $9, $10) ON CONFLICT (Session_ID, Question_ID) DO NOTHING`,
+		req.SessionId, req.QuestionId, req.AnswerId, req.Text, matches, isCorrect, credit,
+		clock.sinceQuestion.Milliseconds(), bonus, penalty)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert session answer: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, status.Error(codes.AlreadyExists, "question is already answered")
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	if late != nil {
+		return nil, late
+	}
+	resp := &v1.SubmitAnswerResponse{
+		IsCorrect:   isCorrect,
+		Credit:      credit,
+		Bonus:       bonus,
+		ElapsedMs:   clock.sinceQuestion.Milliseconds(),
+		Explanation: question.Explanation,
+		HintPenalty: penalty,
+	}
+	for _, a := range question.Answer {
+		resp.Answers = append(resp.Answers, &v1.AnswerFeedback{AnswerId: a.AnswerId, IsCorrect: a.IsCorrect, Feedback: a.Feedback})
+	}
+	return resp, nil
+}
+
+// sessionClock считает время с начала сессии и с последнего ответа по часам базы.
+// Ограничение на вопрос заполняет вызывающий код.
+func sessionClock(ctx context.Context, tx pgx.Tx, sessionID string) (answerClock, error) {
+	var clock answerClock
+	var sinceStart, sinceQuestion int64
+	err := tx.QueryRow(ctx,
+		`SELECT r.Time_limit, r.Speed_bonus,
+			(EXTRACT(EPOCH FROM now() - s.Started_at) * 1000)::bigint,
+			(EXTRACT(EPOCH FROM now() - GREATEST(s.Started_at, MAX(a.Submitted_at))) * 1000)::bigint
+		FROM sessions s
+		JOIN quiz_revisions r ON r.Quiz_ID = s.Quiz_ID AND r.Revision = s.Revision
+		LEFT JOIN session_answers a ON a.Session_ID = s.Session_ID
+		WHERE s.Session_ID = $1
+		GROUP BY r.Time_limit, r.Speed_bonus, s.Started_at`,
+		sessionID).Scan(&clock.quizLimit, &clock.speedBonus, &sinceStart, &sinceQuestion)
+	if err != nil {
+		return clock, fmt.Errorf("failed to get session time: %w", err)
+	}
+	clock.sinceStart = time.Duration(sinceStart) * time.Millisecond
+	clock.sinceQuestion = time.Duration(sinceQuestion) * time.Millisecond
+	return clock, nil
+}
+
+// getQuestion загружает вопрос ревизии квиза вместе с ответами для проверки
+func getQuestion(ctx context.Context, tx pgx.Tx, quizID string, revision int32, questionID string) (*v1.CreateQuestion, error) {
+	var questionType string
+	q := &v1.CreateQuestion{QuestionId: questionID}
+	err := tx.QueryRow(ctx,
+		`SELECT Question_type, Numeric_answer, Numeric_tolerance, Time_limit, Explanation FROM questions
+		WHERE Question_ID = $1 AND Quiz_ID = $2 AND Revision = $3`,
+		questionID, quizID, revision).Scan(&questionType, &q.NumericAnswer, &q.NumericTolerance, &q.TimeLimitSeconds,
+		&q.Explanation)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "question not found in quiz")
+		}
+		return nil, fmt.Errorf("failed to get question: %w", err)
+	}
+	q.QuestionType = questionTypeFromDB(questionType)
+
+	rows, err := tx.Query(ctx,
+		"SELECT Answer_ID, Answer_text, Is_correct, Match_text, Feedback FROM answers WHERE Question_ID = $1 ORDER BY Position",
+		questionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get answers: %w", err)
+	}
+	q.Answer, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (*v1.CreateAnswer, error) {
+		a := &v1.CreateAnswer{}
+		return a, row.Scan(&a.AnswerId, &a.AnswerText, &a.IsCorrect, &a.MatchText, &a.Feedback)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan answer: %w", err)
+	}
+	return q, nil
+}
+
+// FinishSession закрывает сессию и считает итоговый балл в процентах.
+// Надбавка за скорость входит в балл, поэтому он может превышать 100.
+// Повторный вызов возвращает уже посчитанный результат. Закрыть сессию может только ее игрок.
+func (r *Repository) FinishSession(ctx context.Context, player string, sessionID string) (*v1.FinishSessionResponse, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	session, err := lockSession(ctx, tx, sessionID, player)
+	if err != nil {
+		return nil, err
+	}
+	finished := session.finished
+
+	// Из пулов в сессию попадает только часть вопросов, балл считается от выпавших
+	total := int32(len(session.questions))
+	var correct int32
+	if session.questions == nil {
+		err = tx.QueryRow(ctx,
+			"SELECT COUNT(*) FROM questions WHERE Quiz_ID = $1 AND Revision = $2",
+			session.quizID, session.revision).Scan(&total)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count questions: %w", err)
+		}
+	}
+	var credit, bonus float32
+	err = tx.QueryRow(ctx,
+		`SELECT COUNT(*) FILTER (WHERE Is_correct), COALESCE(SUM(Credit), 0), COALESCE(SUM(Bonus), 0)
+		FROM session_answers WHERE Session_ID = $1`,
+		sessionID).Scan(&correct, &credit, &bonus)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count answers: %w", err)
+	}
+
+	// Частично правильные ответы приносят часть баллов за вопрос
+	var score, speedBonus float32
+	if total > 0 {
+		speedBonus = bonus / float32(total) * 100
+		score = credit/float32(total)*100 + speedBonus
+	}
+	if !finished {
+		_, err = tx.Exec(ctx,
+			"UPDATE sessions SET Score = $2, Finished_at = CURRENT_TIMESTAMP WHERE Session_ID = $1",
+			sessionID, score)
+		if err != nil {
+			return nil, fmt.Errorf("failed to finish session: %w", err)
+		}
+	} else {
+		err = tx.QueryRow(ctx,
+			"SELECT Score FROM sessions WHERE Session_ID = $1",
+			sessionID).Scan(&score)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get session: %w", err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return &v1.FinishSessionResponse{
+		SessionId:      sessionID,
+		Score:          score,
+		CorrectCount:   correct,
+		TotalQuestions: total,
+		SpeedBonus:     speedBonus,
+	}, nil
+}
+
+func (r *Repository) GetSessionResult(ctx context.Context, player string, sessionID string) (*v1.GetSessionResultResponse, error) {
+	var quizID, author, owner string
+	var score float32
+	var revision int32
+	var finished bool
+	var seed int64
+	var questionIDs []string
+	var startedAt time.Time
+	var dailyDate, finishedAt *time.Time
+	err := r.pool.QueryRow(ctx,
+		`SELECT s.Quiz_ID, s.Revision, q.Author, s.Player, s.Score, s.Finished_at IS NOT NULL, s.Seed,
+			COALESCE(s.Question_IDs, '{}'), s.Started_at, s.Daily_date, s.Finished_at
+		FROM sessions s JOIN quizzes q ON q.Quiz_ID = s.Quiz_ID
+		WHERE s.Session_ID = $1`,
+		sessionID).Scan(&quizID, &revision, &author, &owner, &score, &finished, &seed, &questionIDs, &startedAt, &dailyDate, &finishedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "session not found")
+		}
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+	// Ответы и правильные варианты сессии видит только ее игрок
+	if owner != "" && owner != player {
+		return nil, status.Error(codes.PermissionDenied, "session belongs to another player")
+	}
+	answers, err := r.sessionAnswers(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetSessionResultResponse{
+		SessionId:   sessionID,
+		QuizId:      quizID,
+		Author:      author,
+		Player:      owner,
+		Score:       score,
+		Finished:    finished,
+		Revision:    revision,
+		Seed:        seed,
+		QuestionIds: questionIDs,
+		StartedAt:   startedAt.Format(time.RFC3339Nano),
+		DailyDate:   formatDay(dailyDate),
+		FinishedAt:  formatTime(finishedAt),
+		Answers:     answers,
+	}, nil
+}
+
+// sessionAnswers возвращает ответы сессии в порядке отправки. Для вопросов с выбором
+// ответа отмечаются выбранные неверные варианты.
+func (r *Repository) sessionAnswers(ctx context.Context, sessionID string) ([]*v1.SessionAnswerResult, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT sa.Question_ID, sa.Is_correct, sa.Elapsed_ms,
+			CASE WHEN q.Question_type IN ('single_choice', 'multi_select', 'true_false') THEN
+				ARRAY(SELECT id FROM unnest(sa.Answer_IDs) AS id
+					WHERE NOT EXISTS (SELECT 1 FROM answers a WHERE a.Answer_ID = id AND a.Is_correct))
+			ELSE '{}' END
+		FROM session_answers sa JOIN questions q ON q.Question_ID = sa.Question_ID
+		WHERE sa.Session_ID = $1
+		ORDER BY sa.Submitted_at, sa.Question_ID`,
+		sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session answers: %w", err)
+	}
+	defer rows.Close()
+	var answers []*v1.SessionAnswerResult
+	for rows.Next() {
+		answer := &v1.SessionAnswerResult{}
+		if err := rows.Scan(&answer.QuestionId, &answer.IsCorrect, &answer.ElapsedMs, &answer.WrongAnswerIds); err != nil {
+			return nil, fmt.Errorf("failed to scan session answer: %w", err)
+		}
+		answers = append(answers, answer)
+	}
+	return answers, rows.Err()
+}
+
+// formatTime возвращает время в RFC 3339, пустую строку для nil
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+type sessionState struct {
+	player   string
+	quizID   string
+	revision int32
+	finished bool
+	// questions - выпавшие в сессии вопросы, nil у сессий, начатых до появления пулов
+	questions []string
+}
+
+// drew проверяет, что вопрос выпал в сессии
+func (s sessionState) drew(questionID string) bool {
+	return s.questions == nil || slices.Contains(s.questions, questionID)
+}
+
+// lockSession блокирует строку сессии до конца транзакции и проверяет, что ее ведет player.
+// Сессию без игрока может вести любой, у кого есть ее ID.
+func lockSession(ctx context.Context, tx pgx.Tx, sessionID string, player string) (sessionState, error) {
+	var state sessionState
+	err := tx.QueryRow(ctx,
+		"SELECT Player, Quiz_ID, Revision, Finished_at IS NOT NULL, Question_IDs FROM sessions WHERE Session_ID = $1 FOR UPDATE",
+		sessionID).Scan(&state.player, &state.quizID, &state.revision, &state.finished, &state.questions)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return state, status.Error(codes.NotFound, "session not found")
+		}
+		return state, fmt.Errorf("failed to get session: %w", err)
+	}
+	if state.player != "" && state.player != player {
+		return state, status.Error(codes.PermissionDenied, "session belongs to another player")
+	}
+	return state, nil
+}
+
+// sameAnswers сравнивает выбранные ответы с правильными без учета порядка
+func sameAnswers(selected []string, correct []string) bool {
+	if len(correct) == 0 {
+		return false
+	}
+	chosen := make(map[string]struct{}, len(selected))
+	for _, id := range selected {
+		chosen[id] = struct{}{}
+	}
+	if len(chosen) != len(correct) {
+		return false
+	}
+	for _, id := range correct {
+		if _, ok := chosen[id]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "quizzes/pkg/api/v1"
+
+	"github.com/pashagolub/pgxmock/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// expectSession ждет блокировку сессии игрока owner и откат транзакции
+func expectSession(mock pgxmock.PgxPoolIface, owner string) {
+	mock.ExpectBegin()
+	mock.ExpectQuery("FROM sessions WHERE Session_ID").WithArgs("session").WillReturnRows(
+		mock.NewRows([]string{"player", "quiz_id", "revision", "finished", "question_ids"}).
+			AddRow(owner, "QUIZ1", int32(1), false, []string{"question"}))
+	mock.ExpectRollback()
+}
+
+func TestSessionForeignPlayer(t *testing.T) {
+	calls := map[string]func(*Repository, string) error{
+		"SubmitAnswer": func(r *Repository, player string) error {
+			_, err := r.SubmitAnswer(context.Background(), player, &v1.SubmitAnswerRequest{SessionId: "session", QuestionId: "question"})
+			return err
+		},
+		"TakeHint": func(r *Repository, player string) error {
+			_, err := r.TakeHint(context.Background(), player, "session", "question")
+			return err
+		},
+		"FinishSession": func(r *Repository, player string) error {
+			_, err := r.FinishSession(context.Background(), player, "session")
+			return err
+		},
+	}
+	for name, call := range calls {
+		for _, player := range []string{"mallory", ""} {
+			t.Run(name+"/"+player, func(t *testing.T) {
+				mock, err := pgxmock.NewPool()
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer mock.Close()
+				expectSession(mock, "alice")
+
+				err = call(&Repository{pool: mock}, player)
+				if status.Code(err) != codes.PermissionDenied {
+					t.Fatalf("got %v, want PermissionDenied", err)
+				}
+				if err = mock.ExpectationsWereMet(); err != nil {
+					t.Fatal(err)
+				}
+			})
+		}
+	}
+}
+
+func TestSessionAnonymousPlayer(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.Close()
+	mock.ExpectBegin()
+	mock.ExpectQuery("FROM sessions WHERE Session_ID").WithArgs("session").WillReturnRows(
+		mock.NewRows([]string{"player", "quiz_id", "revision", "finished", "question_ids"}).
+			AddRow("", "QUIZ1", int32(1), true, []string{"question"}))
+	mock.ExpectRollback()
+
+	// Сессию без игрока ведет любой, поэтому до проверки статуса сессии доходит и чужой вызов
+	_, err = (&Repository{pool: mock}).TakeHint(context.Background(), "mallory", "session", "question")
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("got %v, want FailedPrecondition", err)
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSessionResultForeignPlayer(t *testing.T) {
+	for _, player := range []string{"mallory", ""} {
+		t.Run(player, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer mock.Close()
+			mock.ExpectQuery("FROM sessions s JOIN quizzes q").WithArgs("session").WillReturnRows(
+				mock.NewRows([]string{"quiz_id", "revision", "author", "player", "score", "finished", "seed",
+					"question_ids", "started_at", "daily_date", "finished_at"}).
+					AddRow("QUIZ1", int32(1), "bob", "alice", float32(1), true, int64(0),
+						[]string{"question"}, time.Now(), (*time.Time)(nil), (*time.Time)(nil)))
+
+			// Ответы сессии не читаются, если ее запросил не игрок
+			_, err = (&Repository{pool: mock}).GetSessionResult(context.Background(), player, "session")
+			if status.Code(err) != codes.PermissionDenied {
+				t.Fatalf("got %v, want PermissionDenied", err)
+			}
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
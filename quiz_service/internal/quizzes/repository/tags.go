@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// normalizeTags приводит теги к нижнему регистру, схлопывает пробелы и убирает повторы
+func normalizeTags(tags []string) []string {
+	seen := make(map[string]struct{}, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = normalizeText(tag)
+		if _, ok := seen[tag]; ok || tag == "" {
+			continue
+		}
+		seen[tag] = struct{}{}
+		normalized = append(normalized, tag)
+	}
+	return normalized
+}
+
+// setTags заменяет действующие теги квиза. Ревизия хранит свои теги, они переходят к квизу при публикации.
+func setTags(ctx context.Context, tx pgx.Tx, quizID string, tags []string) error {
+	_, err := tx.Exec(ctx, "DELETE FROM quiz_tags WHERE Quiz_ID = $1", quizID)
+	if err != nil {
+		return fmt.Errorf("failed to delete tags: %w", err)
+	}
+	_, err = tx.Exec(ctx,
+		"INSERT INTO quiz_tags (Quiz_ID, Tag) SELECT $1, unnest($2::text[])",
+		quizID, normalizeTags(tags))
+	if err != nil {
+		return fmt.Errorf("failed to insert tags: %w", err)
+	}
+	return nil
+}
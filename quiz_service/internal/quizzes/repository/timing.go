@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// lateGrace прощает задержку сети между таймером игрока и сервером
+	lateGrace = 2 * time.Second
+	// maxSpeedBonus - доля баллов за вопрос, которую дает мгновенный ответ
+	maxSpeedBonus = 0.5
+)
+
+// answerClock описывает, сколько прошло времени к моменту ответа
+type answerClock struct {
+	quizLimit     int32
+	questionLimit int32
+	speedBonus    bool
+	// sinceStart - время с начала сессии, sinceQuestion - с предыдущего ответа или начала сессии
+	sinceStart    time.Duration
+	sinceQuestion time.Duration
+}
+
+// late возвращает ошибку, если ответ пришел после ограничения квиза или вопроса
+func (c answerClock) late() error {
+	if c.quizLimit > 0 && c.sinceStart > limit(c.quizLimit)+lateGrace {
+		return status.Error(codes.FailedPrecondition, "quiz time limit is exceeded")
+	}
+	if c.questionLimit > 0 && c.sinceQuestion > limit(c.questionLimit)+lateGrace {
+		return status.Error(codes.FailedPrecondition, "question time limit is exceeded")
+	}
+	return nil
+}
+
+// bonus считает надбавку за скорость: чем больше осталось времени на вопрос, тем она больше.
+// Вопросы без ограничения и неправильные ответы надбавку не получают.
+func (c answerClock) bonus(credit float32) float32 {
+	if !c.speedBonus || c.questionLimit <= 0 || credit <= 0 {
+		return 0
+	}
+	left := limit(c.questionLimit) - c.sinceQuestion
+	if left <= 0 {
+		return 0
+	}
+	return credit * maxSpeedBonus * float32(left) / float32(limit(c.questionLimit))
+}
+
+func limit(seconds int32) time.Duration {
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAnswerClockLate(t *testing.T) {
+	tests := []struct {
+		name  string
+		clock answerClock
+		late  bool
+	}{
+		{"no limits", answerClock{sinceStart: time.Hour, sinceQuestion: time.Hour}, false},
+		{"in time", answerClock{questionLimit: 10, sinceQuestion: 9 * time.Second}, false},
+		{"within grace", answerClock{questionLimit: 10, sinceQuestion: 11 * time.Second}, false},
+		{"question late", answerClock{questionLimit: 10, sinceQuestion: 13 * time.Second}, true},
+		{"quiz late", answerClock{quizLimit: 60, sinceStart: 63 * time.Second, sinceQuestion: time.Second}, true},
+	}
+	for _, tt := range tests {
+		if err := tt.clock.late(); (err != nil) != tt.late {
+			t.Errorf("%s: got error %v, want late %v", tt.name, err, tt.late)
+		}
+	}
+}
+
+func TestAnswerClockBonus(t *testing.T) {
+	tests := []struct {
+		name   string
+		clock  answerClock
+		credit float32
+		want   float32
+	}{
+		{"disabled", answerClock{questionLimit: 10}, 1, 0},
+		{"no question limit", answerClock{speedBonus: true, quizLimit: 60}, 1, 0},
+		{"wrong answer", answerClock{speedBonus: true, questionLimit: 10}, 0, 0},
+		{"instant", answerClock{speedBonus: true, questionLimit: 10}, 1, 0.5},
+		{"half time", answerClock{speedBonus: true, questionLimit: 10, sinceQuestion: 5 * time.Second}, 1, 0.25},
+		{"partial credit", answerClock{speedBonus: true, questionLimit: 10, sinceQuestion: 5 * time.Second}, 0.5, 0.125},
+		{"in grace", answerClock{speedBonus: true, questionLimit: 10, sinceQuestion: 11 * time.Second}, 1, 0},
+	}
+	for _, tt := range tests {
+		if got := tt.clock.bonus(tt.credit); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	v1 "quizzes/pkg/api/v1"
+	pb "quizzes/pkg/authapi/v1"
+)
+
+// Видимость квиза в базе
+const (
+	visibilityPublic   = "public"
+	visibilityUnlisted = "unlisted"
+	visibilityFriends  = "friends"
+	visibilityPrivate  = "private"
+)
+
+var visibilities = map[v1.QuizVisibility]string{
+	v1.QuizVisibility_QUIZ_VISIBILITY_PUBLIC:   visibilityPublic,
+	v1.QuizVisibility_QUIZ_VISIBILITY_UNLISTED: visibilityUnlisted,
+	v1.QuizVisibility_QUIZ_VISIBILITY_FRIENDS:  visibilityFriends,
+	v1.QuizVisibility_QUIZ_VISIBILITY_PRIVATE:  visibilityPrivate,
+}
+
+func visibilityFromDB(visibility string) v1.QuizVisibility {
+	for v, name := range visibilities {
+		if name == visibility {
+			return v
+		}
+	}
+	return v1.QuizVisibility_QUIZ_VISIBILITY_PUBLIC
+}
+
+// listedFilter - условие для списков и поиска: публичные квизы и квизы для друзей,
+// если автор есть в friendsParam
+func listedFilter(alias string, friendsParam string) string {
+	return fmt.Sprintf("(%[1]s.Visibility = '%[3]s' OR (%[1]s.Visibility = '%[4]s' AND %[1]s.Author = ANY(%[2]s::text[])))",
+		alias, friendsParam, visibilityPublic, visibilityFriends)
+}
+
+// friendOf возвращает авторов, добавивших вызывающего в друзья. Без токена или при
+// недоступном auth_service список пуст, и квизы для друзей просто не видны.
+func (r *Repository) friendOf(ctx context.Context) []string {
+	token, err := bearerToken(ctx)
+	if err != nil || r.auth == nil {
+		return []string{}
+	}
+	resp, err := r.auth.GetFriendOf(withBearerToken(ctx, token), &pb.GetFriendOfRequest{})
+	if err != nil {
+		return []string{}
+	}
+	return append([]string{}, resp.GetUsernames()...)
+}
+
+// canView проверяет видимость квиза для viewer. Незарегистрированный viewer передается пустой строкой.
+func (r *Repository) canView(ctx context.Context, author string, visibility v1.QuizVisibility, viewer string) bool {
+	if viewer != "" && author == viewer {
+		return true
+	}
+	switch visibility {
+	case v1.QuizVisibility_QUIZ_VISIBILITY_PUBLIC, v1.QuizVisibility_QUIZ_VISIBILITY_UNLISTED:
+		return true
+	case v1.QuizVisibility_QUIZ_VISIBILITY_FRIENDS:
+		return viewer != "" && slices.Contains(r.friendOf(ctx), author)
+	}
+	return false
+}
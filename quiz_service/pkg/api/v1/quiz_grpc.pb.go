@@ -8,6 +8,7 @@ package v1
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -19,10 +20,33 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	QuizService_CreateQuiz_FullMethodName      = "/api.QuizService/CreateQuiz"
-	QuizService_GetQuiz_FullMethodName         = "/api.QuizService/GetQuiz"
-	QuizService_GetQuizByAuthor_FullMethodName = "/api.QuizService/GetQuizByAuthor"
-	QuizService_ListAll_FullMethodName         = "/api.QuizService/ListAll"
+	QuizService_CreateQuiz_FullMethodName        = "/api.QuizService/CreateQuiz"
+	QuizService_GetQuiz_FullMethodName           = "/api.QuizService/GetQuiz"
+	QuizService_GetQuizByAuthor_FullMethodName   = "/api.QuizService/GetQuizByAuthor"
+	QuizService_ListAll_FullMethodName           = "/api.QuizService/ListAll"
+	QuizService_SearchQuizzes_FullMethodName     = "/api.QuizService/SearchQuizzes"
+	QuizService_ListCategories_FullMethodName    = "/api.QuizService/ListCategories"
+	QuizService_ListQuizzesByTag_FullMethodName  = "/api.QuizService/ListQuizzesByTag"
+	QuizService_GetPopularTags_FullMethodName    = "/api.QuizService/GetPopularTags"
+	QuizService_StartSession_FullMethodName      = "/api.QuizService/StartSession"
+	QuizService_SubmitAnswer_FullMethodName      = "/api.QuizService/SubmitAnswer"
+	QuizService_TakeHint_FullMethodName          = "/api.QuizService/TakeHint"
+	QuizService_FinishSession_FullMethodName     = "/api.QuizService/FinishSession"
+	QuizService_UpdateQuiz_FullMethodName        = "/api.QuizService/UpdateQuiz"
+	QuizService_DeleteQuiz_FullMethodName        = "/api.QuizService/DeleteQuiz"
+	QuizService_DiffRevisions_FullMethodName     = "/api.QuizService/DiffRevisions"
+	QuizService_RollbackQuiz_FullMethodName      = "/api.QuizService/RollbackQuiz"
+	QuizService_SaveDraft_FullMethodName         = "/api.QuizService/SaveDraft"
+	QuizService_PublishQuiz_FullMethodName       = "/api.QuizService/PublishQuiz"
+	QuizService_ArchiveQuiz_FullMethodName       = "/api.QuizService/ArchiveQuiz"
+	QuizService_UploadImage_FullMethodName       = "/api.QuizService/UploadImage"
+	QuizService_GetImage_FullMethodName          = "/api.QuizService/GetImage"
+	QuizService_ExportQuiz_FullMethodName        = "/api.QuizService/ExportQuiz"
+	QuizService_ImportQuiz_FullMethodName        = "/api.QuizService/ImportQuiz"
+	QuizService_CloneQuiz_FullMethodName         = "/api.QuizService/CloneQuiz"
+	QuizService_GetDailyQuiz_FullMethodName      = "/api.QuizService/GetDailyQuiz"
+	QuizService_StartDailySession_FullMethodName = "/api.QuizService/StartDailySession"
+	QuizService_GetSessionResult_FullMethodName  = "/api.QuizService/GetSessionResult"
 )
 
 // QuizServiceClient is the client API for QuizService service.
@@ -33,6 +57,36 @@ type QuizServiceClient interface {
 	GetQuiz(ctx context.Context, in *GetQuizRequest, opts ...grpc.CallOption) (*GetQuizResponse, error)
 	GetQuizByAuthor(ctx context.Context, in *GetQuizByAuthorRequest, opts ...grpc.CallOption) (*GetQuizByAuthorResponse, error)
 	ListAll(ctx context.Context, in *ListAllRequest, opts ...grpc.CallOption) (*ListAllResponse, error)
+	SearchQuizzes(ctx context.Context, in *SearchQuizzesRequest, opts ...grpc.CallOption) (*SearchQuizzesResponse, error)
+	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	// Browses published quizzes by tag, by category with its subcategories, or by both.
+	ListQuizzesByTag(ctx context.Context, in *ListQuizzesByTagRequest, opts ...grpc.CallOption) (*ListAllResponse, error)
+	GetPopularTags(ctx context.Context, in *GetPopularTagsRequest, opts ...grpc.CallOption) (*GetPopularTagsResponse, error)
+	StartSession(ctx context.Context, in *StartSessionRequest, opts ...grpc.CallOption) (*StartSessionResponse, error)
+	SubmitAnswer(ctx context.Context, in *SubmitAnswerRequest, opts ...grpc.CallOption) (*SubmitAnswerResponse, error)
+	// Reveals the next hint of a question, its cost is taken from the question's points.
+	TakeHint(ctx context.Context, in *TakeHintRequest, opts ...grpc.CallOption) (*TakeHintResponse, error)
+	FinishSession(ctx context.Context, in *FinishSessionRequest, opts ...grpc.CallOption) (*FinishSessionResponse, error)
+	UpdateQuiz(ctx context.Context, in *UpdateQuizRequest, opts ...grpc.CallOption) (*UpdateQuizResponse, error)
+	DeleteQuiz(ctx context.Context, in *DeleteQuizRequest, opts ...grpc.CallOption) (*DeleteQuizResponse, error)
+	DiffRevisions(ctx context.Context, in *DiffRevisionsRequest, opts ...grpc.CallOption) (*DiffRevisionsResponse, error)
+	RollbackQuiz(ctx context.Context, in *RollbackQuizRequest, opts ...grpc.CallOption) (*UpdateQuizResponse, error)
+	SaveDraft(ctx context.Context, in *SaveDraftRequest, opts ...grpc.CallOption) (*SaveDraftResponse, error)
+	PublishQuiz(ctx context.Context, in *PublishQuizRequest, opts ...grpc.CallOption) (*PublishQuizResponse, error)
+	ArchiveQuiz(ctx context.Context, in *ArchiveQuizRequest, opts ...grpc.CallOption) (*ArchiveQuizResponse, error)
+	UploadImage(ctx context.Context, in *UploadImageRequest, opts ...grpc.CallOption) (*UploadImageResponse, error)
+	// Returns the raw image bytes with their content type.
+	GetImage(ctx context.Context, in *GetImageRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// Returns the quiz with correct answers as a file, the author only.
+	ExportQuiz(ctx context.Context, in *ExportQuizRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	ImportQuiz(ctx context.Context, in *ImportQuizRequest, opts ...grpc.CallOption) (*ImportQuizResponse, error)
+	CloneQuiz(ctx context.Context, in *CloneQuizRequest, opts ...grpc.CallOption) (*CloneQuizResponse, error)
+	// Quiz of the day, the same for every player, picked at midnight UTC.
+	GetDailyQuiz(ctx context.Context, in *GetDailyQuizRequest, opts ...grpc.CallOption) (*GetDailyQuizResponse, error)
+	// Starts the caller's only graded attempt at today's quiz.
+	StartDailySession(ctx context.Context, in *StartDailySessionRequest, opts ...grpc.CallOption) (*StartSessionResponse, error)
+	// Used by stat_service to verify a score before accepting it, not exposed over REST.
+	GetSessionResult(ctx context.Context, in *GetSessionResultRequest, opts ...grpc.CallOption) (*GetSessionResultResponse, error)
 }
 
 type quizServiceClient struct {
@@ -83,6 +137,236 @@ func (c *quizServiceClient) ListAll(ctx context.Context, in *ListAllRequest, opt
 	return out, nil
 }
 
+func (c *quizServiceClient) SearchQuizzes(ctx context.Context, in *SearchQuizzesRequest, opts ...grpc.CallOption) (*SearchQuizzesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchQuizzesResponse)
+	err := c.cc.Invoke(ctx, QuizService_SearchQuizzes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, QuizService_ListCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) ListQuizzesByTag(ctx context.Context, in *ListQuizzesByTagRequest, opts ...grpc.CallOption) (*ListAllResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAllResponse)
+	err := c.cc.Invoke(ctx, QuizService_ListQuizzesByTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) GetPopularTags(ctx context.Context, in *GetPopularTagsRequest, opts ...grpc.CallOption) (*GetPopularTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPopularTagsResponse)
+	err := c.cc.Invoke(ctx, QuizService_GetPopularTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) StartSession(ctx context.Context, in *StartSessionRequest, opts ...grpc.CallOption) (*StartSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartSessionResponse)
+	err := c.cc.Invoke(ctx, QuizService_StartSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) SubmitAnswer(ctx context.Context, in *SubmitAnswerRequest, opts ...grpc.CallOption) (*SubmitAnswerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitAnswerResponse)
+	err := c.cc.Invoke(ctx, QuizService_SubmitAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) TakeHint(ctx context.Context, in *TakeHintRequest, opts ...grpc.CallOption) (*TakeHintResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TakeHintResponse)
+	err := c.cc.Invoke(ctx, QuizService_TakeHint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) FinishSession(ctx context.Context, in *FinishSessionRequest, opts ...grpc.CallOption) (*FinishSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinishSessionResponse)
+	err := c.cc.Invoke(ctx, QuizService_FinishSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) UpdateQuiz(ctx context.Context, in *UpdateQuizRequest, opts ...grpc.CallOption) (*UpdateQuizResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateQuizResponse)
+	err := c.cc.Invoke(ctx, QuizService_UpdateQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) DeleteQuiz(ctx context.Context, in *DeleteQuizRequest, opts ...grpc.CallOption) (*DeleteQuizResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteQuizResponse)
+	err := c.cc.Invoke(ctx, QuizService_DeleteQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) DiffRevisions(ctx context.Context, in *DiffRevisionsRequest, opts ...grpc.CallOption) (*DiffRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffRevisionsResponse)
+	err := c.cc.Invoke(ctx, QuizService_DiffRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) RollbackQuiz(ctx context.Context, in *RollbackQuizRequest, opts ...grpc.CallOption) (*UpdateQuizResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateQuizResponse)
+	err := c.cc.Invoke(ctx, QuizService_RollbackQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) SaveDraft(ctx context.Context, in *SaveDraftRequest, opts ...grpc.CallOption) (*SaveDraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveDraftResponse)
+	err := c.cc.Invoke(ctx, QuizService_SaveDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) PublishQuiz(ctx context.Context, in *PublishQuizRequest, opts ...grpc.CallOption) (*PublishQuizResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishQuizResponse)
+	err := c.cc.Invoke(ctx, QuizService_PublishQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) ArchiveQuiz(ctx context.Context, in *ArchiveQuizRequest, opts ...grpc.CallOption) (*ArchiveQuizResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveQuizResponse)
+	err := c.cc.Invoke(ctx, QuizService_ArchiveQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) UploadImage(ctx context.Context, in *UploadImageRequest, opts ...grpc.CallOption) (*UploadImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadImageResponse)
+	err := c.cc.Invoke(ctx, QuizService_UploadImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) GetImage(ctx context.Context, in *GetImageRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, QuizService_GetImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) ExportQuiz(ctx context.Context, in *ExportQuizRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, QuizService_ExportQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) ImportQuiz(ctx context.Context, in *ImportQuizRequest, opts ...grpc.CallOption) (*ImportQuizResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportQuizResponse)
+	err := c.cc.Invoke(ctx, QuizService_ImportQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) CloneQuiz(ctx context.Context, in *CloneQuizRequest, opts ...grpc.CallOption) (*CloneQuizResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloneQuizResponse)
+	err := c.cc.Invoke(ctx, QuizService_CloneQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) GetDailyQuiz(ctx context.Context, in *GetDailyQuizRequest, opts ...grpc.CallOption) (*GetDailyQuizResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDailyQuizResponse)
+	err := c.cc.Invoke(ctx, QuizService_GetDailyQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) StartDailySession(ctx context.Context, in *StartDailySessionRequest, opts ...grpc.CallOption) (*StartSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartSessionResponse)
+	err := c.cc.Invoke(ctx, QuizService_StartDailySession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) GetSessionResult(ctx context.Context, in *GetSessionResultRequest, opts ...grpc.CallOption) (*GetSessionResultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSessionResultResponse)
+	err := c.cc.Invoke(ctx, QuizService_GetSessionResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QuizServiceServer is the server API for QuizService service.
 // All implementations must embed UnimplementedQuizServiceServer
 // for forward compatibility.
@@ -91,6 +375,36 @@ type QuizServiceServer interface {
 	GetQuiz(context.Context, *GetQuizRequest) (*GetQuizResponse, error)
 	GetQuizByAuthor(context.Context, *GetQuizByAuthorRequest) (*GetQuizByAuthorResponse, error)
 	ListAll(context.Context, *ListAllRequest) (*ListAllResponse, error)
+	SearchQuizzes(context.Context, *SearchQuizzesRequest) (*SearchQuizzesResponse, error)
+	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
+	// Browses published quizzes by tag, by category with its subcategories, or by both.
+	ListQuizzesByTag(context.Context, *ListQuizzesByTagRequest) (*ListAllResponse, error)
+	GetPopularTags(context.Context, *GetPopularTagsRequest) (*GetPopularTagsResponse, error)
+	StartSession(context.Context, *StartSessionRequest) (*StartSessionResponse, error)
+	SubmitAnswer(context.Context, *SubmitAnswerRequest) (*SubmitAnswerResponse, error)
+	// Reveals the next hint of a question, its cost is taken from the question's points.
+	TakeHint(context.Context, *TakeHintRequest) (*TakeHintResponse, error)
+	FinishSession(context.Context, *FinishSessionRequest) (*FinishSessionResponse, error)
+	UpdateQuiz(context.Context, *UpdateQuizRequest) (*UpdateQuizResponse, error)
+	DeleteQuiz(context.Context, *DeleteQuizRequest) (*DeleteQuizResponse, error)
+	DiffRevisions(context.Context, *DiffRevisionsRequest) (*DiffRevisionsResponse, error)
+	RollbackQuiz(context.Context, *RollbackQuizRequest) (*UpdateQuizResponse, error)
+	SaveDraft(context.Context, *SaveDraftRequest) (*SaveDraftResponse, error)
+	PublishQuiz(context.Context, *PublishQuizRequest) (*PublishQuizResponse, error)
+	ArchiveQuiz(context.Context, *ArchiveQuizRequest) (*ArchiveQuizResponse, error)
+	UploadImage(context.Context, *UploadImageRequest) (*UploadImageResponse, error)
+	// Returns the raw image bytes with their content type.
+	GetImage(context.Context, *GetImageRequest) (*httpbody.HttpBody, error)
+	// Returns the quiz with correct answers as a file, the author only.
+	ExportQuiz(context.Context, *ExportQuizRequest) (*httpbody.HttpBody, error)
+	ImportQuiz(context.Context, *ImportQuizRequest) (*ImportQuizResponse, error)
+	CloneQuiz(context.Context, *CloneQuizRequest) (*CloneQuizResponse, error)
+	// Quiz of the day, the same for every player, picked at midnight UTC.
+	GetDailyQuiz(context.Context, *GetDailyQuizRequest) (*GetDailyQuizResponse, error)
+	// Starts the caller's only graded attempt at today's quiz.
+	StartDailySession(context.Context, *StartDailySessionRequest) (*StartSessionResponse, error)
+	// Used by stat_service to verify a score before accepting it, not exposed over REST.
+	GetSessionResult(context.Context, *GetSessionResultRequest) (*GetSessionResultResponse, error)
 	mustEmbedUnimplementedQuizServiceServer()
 }
 
@@ -113,6 +427,75 @@ func (UnimplementedQuizServiceServer) GetQuizByAuthor(context.Context, *GetQuizB
 func (UnimplementedQuizServiceServer) ListAll(context.Context, *ListAllRequest) (*ListAllResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAll not implemented")
 }
+func (UnimplementedQuizServiceServer) SearchQuizzes(context.Context, *SearchQuizzesRequest) (*SearchQuizzesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchQuizzes not implemented")
+}
+func (UnimplementedQuizServiceServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedQuizServiceServer) ListQuizzesByTag(context.Context, *ListQuizzesByTagRequest) (*ListAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuizzesByTag not implemented")
+}
+func (UnimplementedQuizServiceServer) GetPopularTags(context.Context, *GetPopularTagsRequest) (*GetPopularTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPopularTags not implemented")
+}
+func (UnimplementedQuizServiceServer) StartSession(context.Context, *StartSessionRequest) (*StartSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartSession not implemented")
+}
+func (UnimplementedQuizServiceServer) SubmitAnswer(context.Context, *SubmitAnswerRequest) (*SubmitAnswerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAnswer not implemented")
+}
+func (UnimplementedQuizServiceServer) TakeHint(context.Context, *TakeHintRequest) (*TakeHintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TakeHint not implemented")
+}
+func (UnimplementedQuizServiceServer) FinishSession(context.Context, *FinishSessionRequest) (*FinishSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishSession not implemented")
+}
+func (UnimplementedQuizServiceServer) UpdateQuiz(context.Context, *UpdateQuizRequest) (*UpdateQuizResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQuiz not implemented")
+}
+func (UnimplementedQuizServiceServer) DeleteQuiz(context.Context, *DeleteQuizRequest) (*DeleteQuizResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuiz not implemented")
+}
+func (UnimplementedQuizServiceServer) DiffRevisions(context.Context, *DiffRevisionsRequest) (*DiffRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffRevisions not implemented")
+}
+func (UnimplementedQuizServiceServer) RollbackQuiz(context.Context, *RollbackQuizRequest) (*UpdateQuizResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackQuiz not implemented")
+}
+func (UnimplementedQuizServiceServer) SaveDraft(context.Context, *SaveDraftRequest) (*SaveDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveDraft not implemented")
+}
+func (UnimplementedQuizServiceServer) PublishQuiz(context.Context, *PublishQuizRequest) (*PublishQuizResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishQuiz not implemented")
+}
+func (UnimplementedQuizServiceServer) ArchiveQuiz(context.Context, *ArchiveQuizRequest) (*ArchiveQuizResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveQuiz not implemented")
+}
+func (UnimplementedQuizServiceServer) UploadImage(context.Context, *UploadImageRequest) (*UploadImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadImage not implemented")
+}
+func (UnimplementedQuizServiceServer) GetImage(context.Context, *GetImageRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImage not implemented")
+}
+func (UnimplementedQuizServiceServer) ExportQuiz(context.Context, *ExportQuizRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportQuiz not implemented")
+}
+func (UnimplementedQuizServiceServer) ImportQuiz(context.Context, *ImportQuizRequest) (*ImportQuizResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportQuiz not implemented")
+}
+func (UnimplementedQuizServiceServer) CloneQuiz(context.Context, *CloneQuizRequest) (*CloneQuizResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneQuiz not implemented")
+}
+func (UnimplementedQuizServiceServer) GetDailyQuiz(context.Context, *GetDailyQuizRequest) (*GetDailyQuizResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDailyQuiz not implemented")
+}
+func (UnimplementedQuizServiceServer) StartDailySession(context.Context, *StartDailySessionRequest) (*StartSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartDailySession not implemented")
+}
+func (UnimplementedQuizServiceServer) GetSessionResult(context.Context, *GetSessionResultRequest) (*GetSessionResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionResult not implemented")
+}
 func (UnimplementedQuizServiceServer) mustEmbedUnimplementedQuizServiceServer() {}
 func (UnimplementedQuizServiceServer) testEmbeddedByValue()                     {}
 
@@ -206,30 +589,638 @@ func _QuizService_ListAll_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-// QuizService_ServiceDesc is the grpc.ServiceDesc for QuizService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var QuizService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "api.QuizService",
-	HandlerType: (*QuizServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateQuiz",
-			Handler:    _QuizService_CreateQuiz_Handler,
-		},
-		{
-			MethodName: "GetQuiz",
-			Handler:    _QuizService_GetQuiz_Handler,
-		},
-		{
-			MethodName: "GetQuizByAuthor",
-			Handler:    _QuizService_GetQuizByAuthor_Handler,
-		},
-		{
-			MethodName: "ListAll",
-			Handler:    _QuizService_ListAll_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
+func _QuizService_SearchQuizzes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchQuizzesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).SearchQuizzes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_SearchQuizzes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).SearchQuizzes(ctx, req.(*SearchQuizzesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_ListQuizzesByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuizzesByTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).ListQuizzesByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_ListQuizzesByTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).ListQuizzesByTag(ctx, req.(*ListQuizzesByTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_GetPopularTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPopularTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).GetPopularTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_GetPopularTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).GetPopularTags(ctx, req.(*GetPopularTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_StartSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).StartSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_StartSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).StartSession(ctx, req.(*StartSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_SubmitAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).SubmitAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_SubmitAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).SubmitAnswer(ctx, req.(*SubmitAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_TakeHint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TakeHintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).TakeHint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_TakeHint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).TakeHint(ctx, req.(*TakeHintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_FinishSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).FinishSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_FinishSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).FinishSession(ctx, req.(*FinishSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_UpdateQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).UpdateQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_UpdateQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).UpdateQuiz(ctx, req.(*UpdateQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_DeleteQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).DeleteQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_DeleteQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).DeleteQuiz(ctx, req.(*DeleteQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_DiffRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).DiffRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_DiffRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).DiffRevisions(ctx, req.(*DiffRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_RollbackQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).RollbackQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_RollbackQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).RollbackQuiz(ctx, req.(*RollbackQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_SaveDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).SaveDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_SaveDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).SaveDraft(ctx, req.(*SaveDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_PublishQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).PublishQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_PublishQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).PublishQuiz(ctx, req.(*PublishQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_ArchiveQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).ArchiveQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_ArchiveQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).ArchiveQuiz(ctx, req.(*ArchiveQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_UploadImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).UploadImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_UploadImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).UploadImage(ctx, req.(*UploadImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_GetImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).GetImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_GetImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).GetImage(ctx, req.(*GetImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_ExportQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).ExportQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_ExportQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).ExportQuiz(ctx, req.(*ExportQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_ImportQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).ImportQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_ImportQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).ImportQuiz(ctx, req.(*ImportQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_CloneQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).CloneQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_CloneQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).CloneQuiz(ctx, req.(*CloneQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_GetDailyQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDailyQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).GetDailyQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_GetDailyQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).GetDailyQuiz(ctx, req.(*GetDailyQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_StartDailySession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartDailySessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).StartDailySession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_StartDailySession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).StartDailySession(ctx, req.(*StartDailySessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_GetSessionResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).GetSessionResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_GetSessionResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).GetSessionResult(ctx, req.(*GetSessionResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// QuizService_ServiceDesc is the grpc.ServiceDesc for QuizService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QuizService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.QuizService",
+	HandlerType: (*QuizServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateQuiz",
+			Handler:    _QuizService_CreateQuiz_Handler,
+		},
+		{
+			MethodName: "GetQuiz",
+			Handler:    _QuizService_GetQuiz_Handler,
+		},
+		{
+			MethodName: "GetQuizByAuthor",
+			Handler:    _QuizService_GetQuizByAuthor_Handler,
+		},
+		{
+			MethodName: "ListAll",
+			Handler:    _QuizService_ListAll_Handler,
+		},
+		{
+			MethodName: "SearchQuizzes",
+			Handler:    _QuizService_SearchQuizzes_Handler,
+		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _QuizService_ListCategories_Handler,
+		},
+		{
+			MethodName: "ListQuizzesByTag",
+			Handler:    _QuizService_ListQuizzesByTag_Handler,
+		},
+		{
+			MethodName: "GetPopularTags",
+			Handler:    _QuizService_GetPopularTags_Handler,
+		},
+		{
+			MethodName: "StartSession",
+			Handler:    _QuizService_StartSession_Handler,
+		},
+		{
+			MethodName: "SubmitAnswer",
+			Handler:    _QuizService_SubmitAnswer_Handler,
+		},
+		{
+			MethodName: "TakeHint",
+			Handler:    _QuizService_TakeHint_Handler,
+		},
+		{
+			MethodName: "FinishSession",
+			Handler:    _QuizService_FinishSession_Handler,
+		},
+		{
+			MethodName: "UpdateQuiz",
+			Handler:    _QuizService_UpdateQuiz_Handler,
+		},
+		{
+			MethodName: "DeleteQuiz",
+			Handler:    _QuizService_DeleteQuiz_Handler,
+		},
+		{
+			MethodName: "DiffRevisions",
+			Handler:    _QuizService_DiffRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackQuiz",
+			Handler:    _QuizService_RollbackQuiz_Handler,
+		},
+		{
+			MethodName: "SaveDraft",
+			Handler:    _QuizService_SaveDraft_Handler,
+		},
+		{
+			MethodName: "PublishQuiz",
+			Handler:    _QuizService_PublishQuiz_Handler,
+		},
+		{
+			MethodName: "ArchiveQuiz",
+			Handler:    _QuizService_ArchiveQuiz_Handler,
+		},
+		{
+			MethodName: "UploadImage",
+			Handler:    _QuizService_UploadImage_Handler,
+		},
+		{
+			MethodName: "GetImage",
+			Handler:    _QuizService_GetImage_Handler,
+		},
+		{
+			MethodName: "ExportQuiz",
+			Handler:    _QuizService_ExportQuiz_Handler,
+		},
+		{
+			MethodName: "ImportQuiz",
+			Handler:    _QuizService_ImportQuiz_Handler,
+		},
+		{
+			MethodName: "CloneQuiz",
+			Handler:    _QuizService_CloneQuiz_Handler,
+		},
+		{
+			MethodName: "GetDailyQuiz",
+			Handler:    _QuizService_GetDailyQuiz_Handler,
+		},
+		{
+			MethodName: "StartDailySession",
+			Handler:    _QuizService_StartDailySession_Handler,
+		},
+		{
+			MethodName: "GetSessionResult",
+			Handler:    _QuizService_GetSessionResult_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "quiz.proto",
+}
+
+const (
+	RoomService_Play_FullMethodName = "/api.RoomService/Play"
+)
+
+// RoomServiceClient is the client API for RoomService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Live rooms: the host plays a quiz on a shared screen and players answer from their phones.
+// Each participant keeps one stream open, the first command must be create or join.
+type RoomServiceClient interface {
+	Play(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[RoomCommand, RoomEvent], error)
+}
+
+type roomServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRoomServiceClient(cc grpc.ClientConnInterface) RoomServiceClient {
+	return &roomServiceClient{cc}
+}
+
+func (c *roomServiceClient) Play(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[RoomCommand, RoomEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RoomService_ServiceDesc.Streams[0], RoomService_Play_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[RoomCommand, RoomEvent]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RoomService_PlayClient = grpc.BidiStreamingClient[RoomCommand, RoomEvent]
+
+// RoomServiceServer is the server API for RoomService service.
+// All implementations must embed UnimplementedRoomServiceServer
+// for forward compatibility.
+//
+// Live rooms: the host plays a quiz on a shared screen and players answer from their phones.
+// Each participant keeps one stream open, the first command must be create or join.
+type RoomServiceServer interface {
+	Play(grpc.BidiStreamingServer[RoomCommand, RoomEvent]) error
+	mustEmbedUnimplementedRoomServiceServer()
+}
+
+// UnimplementedRoomServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRoomServiceServer struct{}
+
+func (UnimplementedRoomServiceServer) Play(grpc.BidiStreamingServer[RoomCommand, RoomEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method Play not implemented")
+}
+func (UnimplementedRoomServiceServer) mustEmbedUnimplementedRoomServiceServer() {}
+func (UnimplementedRoomServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeRoomServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RoomServiceServer will
+// result in compilation errors.
+type UnsafeRoomServiceServer interface {
+	mustEmbedUnimplementedRoomServiceServer()
+}
+
+func RegisterRoomServiceServer(s grpc.ServiceRegistrar, srv RoomServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRoomServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RoomService_ServiceDesc, srv)
+}
+
+func _RoomService_Play_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RoomServiceServer).Play(&grpc.GenericServerStream[RoomCommand, RoomEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RoomService_PlayServer = grpc.BidiStreamingServer[RoomCommand, RoomEvent]
+
+// RoomService_ServiceDesc is the grpc.ServiceDesc for RoomService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RoomService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.RoomService",
+	HandlerType: (*RoomServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Play",
+			Handler:       _RoomService_Play_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "quiz.proto",
 }
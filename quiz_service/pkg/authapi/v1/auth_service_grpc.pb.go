@@ -24,10 +24,12 @@ const (
 	AuthService_Logout_FullMethodName             = "/auth.AuthService/Logout"
 	AuthService_ValidateToken_FullMethodName      = "/auth.AuthService/ValidateToken"
 	AuthService_GetMe_FullMethodName              = "/auth.AuthService/GetMe"
+	AuthService_GetUser_FullMethodName            = "/auth.AuthService/GetUser"
 	AuthService_UpdateMe_FullMethodName           = "/auth.AuthService/UpdateMe"
 	AuthService_AddFriend_FullMethodName          = "/auth.AuthService/AddFriend"
 	AuthService_RemoveFriend_FullMethodName       = "/auth.AuthService/RemoveFriend"
 	AuthService_GetFriends_FullMethodName         = "/auth.AuthService/GetFriends"
+	AuthService_GetFriendOf_FullMethodName        = "/auth.AuthService/GetFriendOf"
 	AuthService_AddFavoriteQuiz_FullMethodName    = "/auth.AuthService/AddFavoriteQuiz"
 	AuthService_GetFavoriteQuizzes_FullMethodName = "/auth.AuthService/GetFavoriteQuizzes"
 	AuthService_RemoveFavoriteQuiz_FullMethodName = "/auth.AuthService/RemoveFavoriteQuiz"
@@ -44,11 +46,14 @@ type AuthServiceClient interface {
 	ValidateToken(ctx context.Context, in *ValidateTokenRequest, opts ...grpc.CallOption) (*ValidateTokenResponse, error)
 	// Users
 	GetMe(ctx context.Context, in *GetMeRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*UserResponse, error)
 	UpdateMe(ctx context.Context, in *UpdateMeRequest, opts ...grpc.CallOption) (*UserResponse, error)
 	// Friends
 	AddFriend(ctx context.Context, in *AddFriendRequest, opts ...grpc.CallOption) (*FriendResponse, error)
 	RemoveFriend(ctx context.Context, in *RemoveFriendRequest, opts ...grpc.CallOption) (*FriendResponse, error)
 	GetFriends(ctx context.Context, in *GetFriendsRequest, opts ...grpc.CallOption) (*FriendsListResponse, error)
+	// Users who added the caller to their friends, used by quiz_service for friends-only quizzes
+	GetFriendOf(ctx context.Context, in *GetFriendOfRequest, opts ...grpc.CallOption) (*FriendOfResponse, error)
 	// Quizzez
 	AddFavoriteQuiz(ctx context.Context, in *AddFavoriteQuizRequest, opts ...grpc.CallOption) (*FavoriteQuizResponse, error)
 	GetFavoriteQuizzes(ctx context.Context, in *GetFavoriteQuizzesRequest, opts ...grpc.CallOption) (*FavoriteQuizzesResponse, error)
@@ -113,6 +118,16 @@ func (c *authServiceClient) GetMe(ctx context.Context, in *GetMeRequest, opts ..
 	return out, nil
 }
 
+func (c *authServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authServiceClient) UpdateMe(ctx context.Context, in *UpdateMeRequest, opts ...grpc.CallOption) (*UserResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UserResponse)
@@ -153,6 +168,16 @@ func (c *authServiceClient) GetFriends(ctx context.Context, in *GetFriendsReques
 	return out, nil
 }
 
+func (c *authServiceClient) GetFriendOf(ctx context.Context, in *GetFriendOfRequest, opts ...grpc.CallOption) (*FriendOfResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FriendOfResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetFriendOf_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authServiceClient) AddFavoriteQuiz(ctx context.Context, in *AddFavoriteQuizRequest, opts ...grpc.CallOption) (*FavoriteQuizResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(FavoriteQuizResponse)
@@ -194,11 +219,14 @@ type AuthServiceServer interface {
 	ValidateToken(context.Context, *ValidateTokenRequest) (*ValidateTokenResponse, error)
 	// Users
 	GetMe(context.Context, *GetMeRequest) (*UserResponse, error)
+	GetUser(context.Context, *GetUserRequest) (*UserResponse, error)
 	UpdateMe(context.Context, *UpdateMeRequest) (*UserResponse, error)
 	// Friends
 	AddFriend(context.Context, *AddFriendRequest) (*FriendResponse, error)
 	RemoveFriend(context.Context, *RemoveFriendRequest) (*FriendResponse, error)
 	GetFriends(context.Context, *GetFriendsRequest) (*FriendsListResponse, error)
+	// Users who added the caller to their friends, used by quiz_service for friends-only quizzes
+	GetFriendOf(context.Context, *GetFriendOfRequest) (*FriendOfResponse, error)
 	// Quizzez
 	AddFavoriteQuiz(context.Context, *AddFavoriteQuizRequest) (*FavoriteQuizResponse, error)
 	GetFavoriteQuizzes(context.Context, *GetFavoriteQuizzesRequest) (*FavoriteQuizzesResponse, error)
@@ -228,6 +256,9 @@ func (UnimplementedAuthServiceServer) ValidateToken(context.Context, *ValidateTo
 func (UnimplementedAuthServiceServer) GetMe(context.Context, *GetMeRequest) (*UserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMe not implemented")
 }
+func (UnimplementedAuthServiceServer) GetUser(context.Context, *GetUserRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
 func (UnimplementedAuthServiceServer) UpdateMe(context.Context, *UpdateMeRequest) (*UserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateMe not implemented")
 }
@@ -240,6 +271,9 @@ func (UnimplementedAuthServiceServer) RemoveFriend(context.Context, *RemoveFrien
 func (UnimplementedAuthServiceServer) GetFriends(context.Context, *GetFriendsRequest) (*FriendsListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFriends not implemented")
 }
+func (UnimplementedAuthServiceServer) GetFriendOf(context.Context, *GetFriendOfRequest) (*FriendOfResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFriendOf not implemented")
+}
 func (UnimplementedAuthServiceServer) AddFavoriteQuiz(context.Context, *AddFavoriteQuizRequest) (*FavoriteQuizResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddFavoriteQuiz not implemented")
 }
@@ -360,6 +394,24 @@ func _AuthService_GetMe_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuthService_UpdateMe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateMeRequest)
 	if err := dec(in); err != nil {
@@ -432,6 +484,24 @@ func _AuthService_GetFriends_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_GetFriendOf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFriendOfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetFriendOf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetFriendOf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetFriendOf(ctx, req.(*GetFriendOfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuthService_AddFavoriteQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddFavoriteQuizRequest)
 	if err := dec(in); err != nil {
@@ -513,6 +583,10 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMe",
 			Handler:    _AuthService_GetMe_Handler,
 		},
+		{
+			MethodName: "GetUser",
+			Handler:    _AuthService_GetUser_Handler,
+		},
 		{
 			MethodName: "UpdateMe",
 			Handler:    _AuthService_UpdateMe_Handler,
@@ -529,6 +603,10 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFriends",
 			Handler:    _AuthService_GetFriends_Handler,
 		},
+		{
+			MethodName: "GetFriendOf",
+			Handler:    _AuthService_GetFriendOf_Handler,
+		},
 		{
 			MethodName: "AddFavoriteQuiz",
 			Handler:    _AuthService_AddFavoriteQuiz_Handler,
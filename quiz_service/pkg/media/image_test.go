@@ -0,0 +1,62 @@
+package media
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+)
+
+func encodePNG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.SetNRGBA(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 200, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestProcessThumbnail(t *testing.T) {
+	img, err := Process(encodePNG(t, 1024, 512), 1<<20)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if img.ContentType != "image/png" || img.Width != 1024 || img.Height != 512 {
+		t.Fatalf("unexpected image: %s %dx%d", img.ContentType, img.Width, img.Height)
+	}
+	thumb, err := png.Decode(bytes.NewReader(img.Thumbnail))
+	if err != nil {
+		t.Fatalf("thumbnail is not a png: %v", err)
+	}
+	if b := thumb.Bounds(); b.Dx() != ThumbnailSize || b.Dy() != ThumbnailSize/2 {
+		t.Fatalf("unexpected thumbnail size %dx%d", b.Dx(), b.Dy())
+	}
+}
+
+func TestProcessRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		max  int
+		err  error
+	}{
+		{"empty", nil, 1 << 20, ErrUnsupported},
+		{"text with image name", []byte("definitely not an image"), 1 << 20, ErrUnsupported},
+		{"too large", encodePNG(t, 64, 64), 16, ErrTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Process(tt.data, tt.max); !errors.Is(err, tt.err) {
+				t.Fatalf("expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
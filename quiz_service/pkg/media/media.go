@@ -0,0 +1,69 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+type Config struct {
+	Dir     string `yaml:"MEDIA_DIR" env:"MEDIA_DIR" env-default:"./media"`
+	MaxSize int    `yaml:"MEDIA_MAX_SIZE" env:"MEDIA_MAX_SIZE" env-default:"2097152"`
+}
+
+var ErrNotFound = errors.New("media object not found")
+
+// Storage хранит содержимое картинок по ключу
+type Storage interface {
+	Put(ctx context.Context, key string, data []byte) error
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+// FileStorage хранит картинки файлами в локальном каталоге
+type FileStorage struct {
+	dir string
+}
+
+func New(config Config) (*FileStorage, error) {
+	if err := os.MkdirAll(config.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("unable to create media dir: %w", err)
+	}
+	return &FileStorage{dir: config.Dir}, nil
+}
+
+func (s *FileStorage) path(key string) string {
+	return filepath.Join(s.dir, filepath.Base(key))
+}
+
+// Put записывает файл через временный файл, чтобы читатели не увидели его недописанным
+func (s *FileStorage) Put(ctx context.Context, key string, data []byte) error {
+	tmp, err := os.CreateTemp(s.dir, ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err = os.Rename(tmp.Name(), s.path(key)); err != nil {
+		return fmt.Errorf("failed to store file: %w", err)
+	}
+	return nil
+}
+
+func (s *FileStorage) Get(ctx context.Context, key string) ([]byte, error) {
+	data, err := os.ReadFile(s.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	return data, nil
+}
@@ -6,6 +6,7 @@ import (
 
 	_ "github.com/golang-migrate/migrate/database/postgres"
 	_ "github.com/golang-migrate/migrate/source/file"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -52,13 +53,13 @@ func New(ctx context.Context, config Config) (*pgxpool.Pool, error) {
 
 	return conn, nil
 }
-func InitTables(ctx context.Context, pool *pgxpool.Pool) error {
-	conn, err := pool.Acquire(ctx)
 
-	if err != nil {
-		return fmt.Errorf("unable to get connection from pool")
-	}
+// Executor выполняет запросы схемы, им может быть пул или отдельное соединение
+type Executor interface {
+	Exec(context.Context, string, ...any) (pgconn.CommandTag, error)
+}
 
+func InitTables(ctx context.Context, conn Executor) error {
 	query := `CREATE TABLE IF NOT EXISTS quizzes (
     Quiz_ID VARCHAR(255) PRIMARY KEY NOT NULL ,
     Name VARCHAR(255) NOT NULL ,
@@ -71,7 +72,7 @@ func InitTables(ctx context.Context, pool *pgxpool.Pool) error {
 	query = `CREATE TABLE IF NOT EXISTS questions(
 Question_ID VARCHAR(255) PRIMARY KEY NOT NULL,
 Quiz_ID VARCHAR(255) NOT NULL,
-FOREIGN KEY (Quiz_ID) references quizzes(Quiz_ID),
+FOREIGN KEY (Quiz_ID) references quizzes(Quiz_ID) ON DELETE CASCADE,
 Question_text VARCHAR(255),
 Image_ID VARCHAR(255)
 );`
@@ -80,12 +81,252 @@ Image_ID VARCHAR(255)
 	query = `CREATE TABLE IF NOT EXISTS answers(
     Answer_ID VARCHAR(255) PRIMARY KEY NOT NULL,
     Question_ID VARCHAR(255) NOT NULL,
-    FOREIGN KEY (Question_ID) REFERENCES questions(Question_ID),
+    FOREIGN KEY (Question_ID) REFERENCES questions(Question_ID) ON DELETE CASCADE,
     Answer_text VARCHAR(255),
     Is_correct BOOLEAN
 );`
 	conn.Exec(ctx, query)
 
+	query = `ALTER TABLE questions ADD COLUMN IF NOT EXISTS Position INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE answers ADD COLUMN IF NOT EXISTS Position INTEGER NOT NULL DEFAULT 0;`
+	conn.Exec(ctx, query)
+
+	query = `CREATE TABLE IF NOT EXISTS sessions(
+    Session_ID VARCHAR(255) PRIMARY KEY NOT NULL,
+    Quiz_ID VARCHAR(255) NOT NULL,
+    FOREIGN KEY (Quiz_ID) REFERENCES quizzes(Quiz_ID) ON DELETE CASCADE,
+    Player VARCHAR(255) NOT NULL DEFAULT '',
+    Score REAL NOT NULL DEFAULT 0,
+    Started_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    Finished_at TIMESTAMP WITH TIME ZONE
+);`
+	conn.Exec(ctx, query)
+
+	query = `CREATE TABLE IF NOT EXISTS session_answers(
+    Session_ID VARCHAR(255) NOT NULL,
+    Question_ID VARCHAR(255) NOT NULL,
+    FOREIGN KEY (Session_ID) REFERENCES sessions(Session_ID) ON DELETE CASCADE,
+    FOREIGN KEY (Question_ID) REFERENCES questions(Question_ID) ON DELETE CASCADE,
+    Answer_IDs TEXT[] NOT NULL,
+    Is_correct BOOLEAN NOT NULL,
+    Submitted_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (Session_ID, Question_ID)
+);`
+	conn.Exec(ctx, query)
+
+	query = `ALTER TABLE quizzes ADD COLUMN IF NOT EXISTS Revision INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS Revision INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS Revision INTEGER NOT NULL DEFAULT 1;
+CREATE TABLE IF NOT EXISTS quiz_revisions(
+    Quiz_ID VARCHAR(255) NOT NULL,
+    FOREIGN KEY (Quiz_ID) REFERENCES quizzes(Quiz_ID) ON DELETE CASCADE,
+    Revision INTEGER NOT NULL,
+    Name VARCHAR(255) NOT NULL,
+    Image_ID VARCHAR(255),
+    Description VARCHAR(255),
+    Created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (Quiz_ID, Revision)
+);
+CREATE INDEX IF NOT EXISTS idx_questions_quiz_revision ON questions(Quiz_ID, Revision);
+INSERT INTO quiz_revisions (Quiz_ID, Revision, Name, Image_ID, Description)
+SELECT Quiz_ID, Revision, Name, Image_ID, Description FROM quizzes
+ON CONFLICT (Quiz_ID, Revision) DO NOTHING;`
+	conn.Exec(ctx, query)
+
+	// Квизы, созданные до появления черновиков, считаются опубликованными
+	query = `ALTER TABLE quizzes ADD COLUMN IF NOT EXISTS Status VARCHAR(16) NOT NULL DEFAULT 'published'
+    CHECK (Status IN ('draft', 'published', 'archived'));
+ALTER TABLE quizzes ADD COLUMN IF NOT EXISTS Published_revision INTEGER NOT NULL DEFAULT 0;
+UPDATE quizzes SET Published_revision = Revision WHERE Published_revision = 0 AND Status <> 'draft';
+CREATE INDEX IF NOT EXISTS idx_quizzes_status ON quizzes(Status);`
+	conn.Exec(ctx, query)
+
+	// Типы вопросов; старые вопросы остаются с одним правильным ответом
+	query = `ALTER TABLE questions ADD COLUMN IF NOT EXISTS Question_type VARCHAR(16) NOT NULL DEFAULT 'single_choice';
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS Numeric_answer DOUBLE PRECISION;
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS Numeric_tolerance DOUBLE PRECISION NOT NULL DEFAULT 0;
+ALTER TABLE answers ADD COLUMN IF NOT EXISTS Match_text TEXT NOT NULL DEFAULT '';
+ALTER TABLE session_answers ADD COLUMN IF NOT EXISTS Answer_text TEXT NOT NULL DEFAULT '';
+ALTER TABLE session_answers ADD COLUMN IF NOT EXISTS Matches TEXT[];
+ALTER TABLE session_answers ADD COLUMN IF NOT EXISTS Credit REAL NOT NULL DEFAULT 0;
+UPDATE session_answers SET Credit = 1 WHERE Is_correct AND Credit = 0;`
+	conn.Exec(ctx, query)
+
+	// Теги и индексы для постраничного списка квизов
+	query = `ALTER TABLE quizzes ADD COLUMN IF NOT EXISTS Created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP;
+CREATE TABLE IF NOT EXISTS quiz_tags(
+    Quiz_ID VARCHAR(255) NOT NULL,
+    FOREIGN KEY (Quiz_ID) REFERENCES quizzes(Quiz_ID) ON DELETE CASCADE,
+    Tag VARCHAR(64) NOT NULL,
+    PRIMARY KEY (Quiz_ID, Tag)
+);
+CREATE INDEX IF NOT EXISTS idx_quiz_tags_tag ON quiz_tags(Tag);
+CREATE INDEX IF NOT EXISTS idx_quizzes_created ON quizzes(Created_at DESC, Quiz_ID DESC);
+CREATE INDEX IF NOT EXISTS idx_quizzes_name ON quizzes(Name, Quiz_ID);
+CREATE INDEX IF NOT EXISTS idx_quizzes_author ON quizzes(Author);
+CREATE INDEX IF NOT EXISTS idx_answers_question ON answers(Question_ID, Position);`
+	conn.Exec(ctx, query)
+
+	// Полнотекстовый поиск по русскому и английскому тексту
+	query = `ALTER TABLE quizzes ADD COLUMN IF NOT EXISTS Search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('russian'::regconfig, coalesce(Name, '')), 'A') ||
+    setweight(to_tsvector('english'::regconfig, coalesce(Name, '')), 'A') ||
+    setweight(to_tsvector('russian'::regconfig, coalesce(Description, '')), 'B') ||
+    setweight(to_tsvector('english'::regconfig, coalesce(Description, '')), 'B')
+) STORED;
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS Search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('russian'::regconfig, coalesce(Question_text, '')), 'C') ||
+    setweight(to_tsvector('english'::regconfig, coalesce(Question_text, '')), 'C')
+) STORED;
+ALTER TABLE quiz_tags ADD COLUMN IF NOT EXISTS Search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('russian'::regconfig, Tag), 'A') ||
+    setweight(to_tsvector('english'::regconfig, Tag), 'A')
+) STORED;
+CREATE INDEX IF NOT EXISTS idx_quizzes_search ON quizzes USING GIN (Search_vector);
+CREATE INDEX IF NOT EXISTS idx_questions_search ON questions USING GIN (Search_vector);
+CREATE INDEX IF NOT EXISTS idx_quiz_tags_search ON quiz_tags USING GIN (Search_vector);`
+	conn.Exec(ctx, query)
+
+	// Курируемое дерево категорий; новые категории добавляются сюда же
+	query = `CREATE TABLE IF NOT EXISTS categories(
+    Category_ID VARCHAR(64) PRIMARY KEY,
+    Parent_ID VARCHAR(64) REFERENCES categories(Category_ID) ON DELETE CASCADE,
+    Name VARCHAR(255) NOT NULL,
+    Position INTEGER NOT NULL DEFAULT 0
+);
+INSERT INTO categories (Category_ID, Parent_ID, Name, Position) VALUES
+    ('general', NULL, 'Общие знания', 0),
+    ('science', NULL, 'Наука', 1),
+    ('physics', 'science', 'Физика', 0),
+    ('chemistry', 'science', 'Химия', 1),
+    ('biology', 'science', 'Биология', 2),
+    ('math', 'science', 'Математика', 3),
+    ('history', NULL, 'История', 2),
+    ('geography', NULL, 'География', 3),
+    ('culture', NULL, 'Культура', 4),
+    ('movies', 'culture', 'Кино и сериалы', 0),
+    ('music', 'culture', 'Музыка', 1),
+    ('literature', 'culture', 'Литература', 2),
+    ('art', 'culture', 'Искусство', 3),
+    ('sport', NULL, 'Спорт', 5),
+    ('technology', NULL, 'Технологии', 6),
+    ('programming', 'technology', 'Программирование', 0),
+    ('games', NULL, 'Игры', 7),
+    ('languages', NULL, 'Языки', 8)
+ON CONFLICT (Category_ID) DO NOTHING;
+ALTER TABLE quizzes ADD COLUMN IF NOT EXISTS Category_ID VARCHAR(64) REFERENCES categories(Category_ID) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_quizzes_category ON quizzes(Category_ID);`
+	conn.Exec(ctx, query)
+
+	// Загруженные картинки, сами файлы лежат в хранилище media
+	query = `CREATE TABLE IF NOT EXISTS images (
+    Image_ID TEXT PRIMARY KEY,
+    Owner TEXT NOT NULL,
+    Content_type TEXT NOT NULL,
+    Size BIGINT NOT NULL,
+    Width INT NOT NULL,
+    Height INT NOT NULL,
+    Thumbnail_type TEXT NOT NULL,
+    Created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);`
+	conn.Exec(ctx, query)
+
+	// Копии квизов ссылаются на исходный квиз
+	query = `ALTER TABLE quizzes ADD COLUMN IF NOT EXISTS Forked_from TEXT REFERENCES quizzes(Quiz_ID) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_quizzes_forked_from ON quizzes(Forked_from);`
+	conn.Exec(ctx, query)
+
+	// Видимость квиза: public, unlisted, friends или private
+	query = `ALTER TABLE quizzes ADD COLUMN IF NOT EXISTS Visibility TEXT NOT NULL DEFAULT 'public';`
+	conn.Exec(ctx, query)
+
+	// Ограничения времени хранятся в ревизии, время и бонус ответа - в сессии
+	query = `ALTER TABLE quiz_revisions ADD COLUMN IF NOT EXISTS Time_limit INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE quiz_revisions ADD COLUMN IF NOT EXISTS Speed_bonus BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS Time_limit INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE session_answers ADD COLUMN IF NOT EXISTS Elapsed_ms BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE session_answers ADD COLUMN IF NOT EXISTS Bonus REAL NOT NULL DEFAULT 0;`
+	conn.Exec(ctx, query)
+
+	// Перемешивание и пулы вопросов хранятся в ревизии, сессия помнит зерно и выпавшие вопросы
+	query = `ALTER TABLE quiz_revisions ADD COLUMN IF NOT EXISTS Shuffle_questions BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE quiz_revisions ADD COLUMN IF NOT EXISTS Shuffle_answers BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS Pool TEXT NOT NULL DEFAULT '';
+CREATE TABLE IF NOT EXISTS question_pools(
+    Quiz_ID VARCHAR(255) NOT NULL,
+    Revision INTEGER NOT NULL,
+    FOREIGN KEY (Quiz_ID, Revision) REFERENCES quiz_revisions(Quiz_ID, Revision) ON DELETE CASCADE,
+    Name TEXT NOT NULL,
+    Draw INTEGER NOT NULL,
+    Position INTEGER NOT NULL,
+    PRIMARY KEY (Quiz_ID, Revision, Name)
+);
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS Seed BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS Question_IDs TEXT[];`
+	conn.Exec(ctx, query)
+
+	// Пояснения к вопросам и ответам, подсказки и взятые в сессии подсказки
+	query = `ALTER TABLE questions ADD COLUMN IF NOT EXISTS Explanation TEXT;
+ALTER TABLE answers ADD COLUMN IF NOT EXISTS Feedback TEXT NOT NULL DEFAULT '';
+CREATE TABLE IF NOT EXISTS question_hints(
+    Question_ID VARCHAR(255) NOT NULL,
+    FOREIGN KEY (Question_ID) REFERENCES questions(Question_ID) ON DELETE CASCADE,
+    Position INTEGER NOT NULL,
+    Hint_text TEXT NOT NULL,
+    Cost REAL NOT NULL DEFAULT 0,
+    PRIMARY KEY (Question_ID, Position)
+);
+CREATE TABLE IF NOT EXISTS session_hints(
+    Session_ID VARCHAR(255) NOT NULL,
+    FOREIGN KEY (Session_ID) REFERENCES sessions(Session_ID) ON DELETE CASCADE,
+    Question_ID VARCHAR(255) NOT NULL,
+    FOREIGN KEY (Question_ID) REFERENCES questions(Question_ID) ON DELETE CASCADE,
+    Position INTEGER NOT NULL,
+    Cost REAL NOT NULL,
+    Taken_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (Session_ID, Question_ID, Position)
+);
+ALTER TABLE session_answers ADD COLUMN IF NOT EXISTS Hint_penalty REAL NOT NULL DEFAULT 0;`
+	conn.Exec(ctx, query)
+
+	// Квиз дня: один квиз на каждый день по UTC, ежедневная сессия помнит свой день
+	query = `CREATE TABLE IF NOT EXISTS daily_quizzes(
+    Day DATE PRIMARY KEY,
+    Quiz_ID VARCHAR(255) NOT NULL,
+    FOREIGN KEY (Quiz_ID) REFERENCES quizzes(Quiz_ID) ON DELETE CASCADE,
+    Strategy TEXT NOT NULL,
+    Picked_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_daily_quizzes_quiz ON daily_quizzes(Quiz_ID);
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS Daily_date DATE;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_sessions_daily ON sessions(Player, Daily_date) WHERE Daily_date IS NOT NULL;`
+	conn.Exec(ctx, query)
+
+	// Ревизия помнит, публиковалась ли она; до этого опубликованной была только текущая ревизия квиза
+	query = `ALTER TABLE quiz_revisions ADD COLUMN IF NOT EXISTS Published_at TIMESTAMP WITH TIME ZONE;
+UPDATE quiz_revisions r SET Published_at = r.Created_at FROM quizzes q
+    WHERE q.Quiz_ID = r.Quiz_ID AND r.Revision = q.Published_revision AND r.Published_at IS NULL;`
+	conn.Exec(ctx, query)
+
+	// Черновая ревизия хранит свои видимость, категорию и теги до публикации, у старых ревизий они пустые
+	query = `ALTER TABLE quiz_revisions ADD COLUMN IF NOT EXISTS Visibility TEXT;
+ALTER TABLE quiz_revisions ADD COLUMN IF NOT EXISTS Category_ID VARCHAR(64) REFERENCES categories(Category_ID) ON DELETE SET NULL;
+ALTER TABLE quiz_revisions ADD COLUMN IF NOT EXISTS Tags TEXT[];`
+	conn.Exec(ctx, query)
+
+	// Таблицы, созданные до появления удаления квизов, получают каскадные внешние ключи
+	query = `ALTER TABLE questions DROP CONSTRAINT IF EXISTS questions_quiz_id_fkey,
+    ADD CONSTRAINT questions_quiz_id_fkey FOREIGN KEY (Quiz_ID) REFERENCES quizzes(Quiz_ID) ON DELETE CASCADE;
+ALTER TABLE answers DROP CONSTRAINT IF EXISTS answers_question_id_fkey,
+    ADD CONSTRAINT answers_question_id_fkey FOREIGN KEY (Question_ID) REFERENCES questions(Question_ID) ON DELETE CASCADE;
+ALTER TABLE sessions DROP CONSTRAINT IF EXISTS sessions_quiz_id_fkey,
+    ADD CONSTRAINT sessions_quiz_id_fkey FOREIGN KEY (Quiz_ID) REFERENCES quizzes(Quiz_ID) ON DELETE CASCADE;
+ALTER TABLE session_answers DROP CONSTRAINT IF EXISTS session_answers_session_id_fkey,
+    ADD CONSTRAINT session_answers_session_id_fkey FOREIGN KEY (Session_ID) REFERENCES sessions(Session_ID) ON DELETE CASCADE;
+ALTER TABLE session_answers DROP CONSTRAINT IF EXISTS session_answers_question_id_fkey,
+    ADD CONSTRAINT session_answers_question_id_fkey FOREIGN KEY (Question_ID) REFERENCES questions(Question_ID) ON DELETE CASCADE;`
+	conn.Exec(ctx, query)
+
 	return nil
 
 }
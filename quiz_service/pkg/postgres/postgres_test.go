@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// schema собирает колонки таблиц из запросов InitTables в порядке их выполнения
+type schema struct {
+	tables map[string]map[string]bool
+	errs   []string
+}
+
+// Строки определения таблицы, которые не являются колонками
+var constraints = map[string]bool{"foreign": true, "primary": true, "unique": true, "check": true, ");": true, ")": true}
+
+func (s *schema) Exec(_ context.Context, query string, _ ...any) (pgconn.CommandTag, error) {
+	for _, stmt := range strings.Split(query, ";") {
+		fields := strings.Fields(strings.ToLower(stmt))
+		switch {
+		case len(fields) > 5 && strings.Join(fields[:5], " ") == "create table if not exists":
+			name, _, _ := strings.Cut(fields[5], "(")
+			if s.tables[name] != nil {
+				continue
+			}
+			s.tables[name] = map[string]bool{}
+			for _, line := range strings.Split(stmt[strings.Index(stmt, "(")+1:], "\n") {
+				words := strings.Fields(strings.ToLower(line))
+				if len(words) == 0 || constraints[words[0]] {
+					continue
+				}
+				s.tables[name][words[0]] = true
+			}
+		case len(fields) > 8 && fields[0] == "alter" && fields[3] == "add" && fields[4] == "column":
+			table := s.tables[fields[2]]
+			if table == nil {
+				s.errs = append(s.errs, fmt.Sprintf("column %s added before table %s exists", fields[8], fields[2]))
+				continue
+			}
+			table[fields[8]] = true
+		}
+	}
+	return pgconn.CommandTag{}, nil
+}
+
+func TestInitTablesColumns(t *testing.T) {
+	s := &schema{tables: map[string]map[string]bool{}}
+	if err := InitTables(context.Background(), s); err != nil {
+		t.Fatal(err)
+	}
+	for _, err := range s.errs {
+		t.Error(err)
+	}
+	// Колонки, которые репозиторий пишет и читает при сохранении и публикации ревизий
+	used := map[string][]string{
+		"quizzes": {"quiz_id", "revision", "status", "published_revision", "visibility"},
+		"quiz_revisions": {"quiz_id", "revision", "name", "image_id", "description", "time_limit", "speed_bonus",
+			"shuffle_questions", "shuffle_answers", "created_at", "published_at", "visibility", "category_id", "tags"},
+	}
+	for table, columns := range used {
+		for _, column := range columns {
+			if !s.tables[table][column] {
+				t.Errorf("InitTables does not create %s.%s", table, column)
+			}
+		}
+	}
+}
+
+// TestInitTablesPostgres прогоняет InitTables на настоящей базе из QUIZ_TEST_POSTGRES
+func TestInitTablesPostgres(t *testing.T) {
+	dsn := os.Getenv("QUIZ_TEST_POSTGRES")
+	if dsn == "" {
+		t.Skip("QUIZ_TEST_POSTGRES is not set")
+	}
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pool.Close()
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Release()
+
+	name := fmt.Sprintf("init_tables_%d", time.Now().UnixNano())
+	if _, err = conn.Exec(ctx, "CREATE SCHEMA "+name+"; SET search_path TO "+name); err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Exec(ctx, "RESET search_path; DROP SCHEMA "+name+" CASCADE")
+
+	if err = InitTables(ctx, conn); err != nil {
+		t.Fatal(err)
+	}
+	// Квиз с опубликованной первой ревизией и черновой второй, записанный без Published_at
+	_, err = conn.Exec(ctx, `INSERT INTO quizzes (Quiz_ID, Name, Author, Revision, Published_revision) VALUES ('QUIZ1', 'Quiz', 'alice', 2, 1);
+INSERT INTO quiz_revisions (Quiz_ID, Revision, Name) VALUES ('QUIZ1', 1, 'Quiz'), ('QUIZ1', 2, 'Quiz');`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Повторный запуск, как при каждом старте сервиса, отмечает опубликованную ревизию
+	if err = InitTables(ctx, conn); err != nil {
+		t.Fatal(err)
+	}
+	published := func(revision int32) bool {
+		var ok bool
+		err := conn.QueryRow(ctx,
+			"SELECT Published_at IS NOT NULL FROM quiz_revisions WHERE Quiz_ID = $1 AND Revision = $2",
+			"QUIZ1", revision).Scan(&ok)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ok
+	}
+	if !published(1) || published(2) {
+		t.Fatalf("got published %v/%v, want true/false", published(1), published(2))
+	}
+
+	// Запросы репозитория к ревизиям работают на созданной схеме
+	_, err = conn.Exec(ctx,
+		`INSERT INTO quiz_revisions (Quiz_ID, Revision, Name, Image_ID, Description, Time_limit, Speed_bonus,
+			Shuffle_questions, Shuffle_answers, Published_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, CASE WHEN $10 THEN CURRENT_TIMESTAMP END)`,
+		"QUIZ1", int32(3), "Quiz", nil, nil, int32(0), false, false, false, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = conn.Exec(ctx,
+		"UPDATE quiz_revisions SET Published_at = COALESCE(Published_at, CURRENT_TIMESTAMP) WHERE Quiz_ID = $1 AND Revision = $2",
+		"QUIZ1", int32(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !published(2) || !published(3) {
+		t.Fatalf("got published %v/%v, want true/true", published(2), published(3))
+	}
+}
@@ -0,0 +1,106 @@
+package rooms
+
+import (
+	"context"
+	"sync"
+)
+
+// subscriberBuffer - сколько событий подписчик может отставать, прежде чем его отключат
+const subscriberBuffer = 64
+
+// MemoryStore держит комнаты в памяти процесса
+type MemoryStore struct {
+	mu          sync.Mutex
+	rooms       map[string]*Room
+	subscribers map[string]map[chan []byte]struct{}
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		rooms:       make(map[string]*Room),
+		subscribers: make(map[string]map[chan []byte]struct{}),
+	}
+}
+
+func (s *MemoryStore) Create(ctx context.Context, room *Room) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.rooms[room.ID]; ok {
+		return ErrExists
+	}
+	s.rooms[room.ID] = room.clone()
+	return nil
+}
+
+func (s *MemoryStore) Get(ctx context.Context, id string) (*Room, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	room, ok := s.rooms[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return room.clone(), nil
+}
+
+func (s *MemoryStore) Update(ctx context.Context, id string, fn func(*Room) error) (*Room, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	room, ok := s.rooms[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	room = room.clone()
+	if err := fn(room); err != nil {
+		return nil, err
+	}
+	s.rooms[id] = room
+	return room.clone(), nil
+}
+
+func (s *MemoryStore) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.rooms, id)
+	return nil
+}
+
+func (s *MemoryStore) Publish(ctx context.Context, id string, event []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for ch := range s.subscribers[id] {
+		select {
+		case ch <- event:
+		default:
+			// Медленный подписчик теряет подписку, а не тормозит всю комнату
+			s.unsubscribe(id, ch)
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStore) Subscribe(ctx context.Context, id string) (<-chan []byte, func(), error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ch := make(chan []byte, subscriberBuffer)
+	if s.subscribers[id] == nil {
+		s.subscribers[id] = make(map[chan []byte]struct{})
+	}
+	s.subscribers[id][ch] = struct{}{}
+	cancel := func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.unsubscribe(id, ch)
+	}
+	return ch, cancel, nil
+}
+
+func (s *MemoryStore) unsubscribe(id string, ch chan []byte) {
+	if _, ok := s.subscribers[id][ch]; !ok {
+		return
+	}
+	delete(s.subscribers[id], ch)
+	if len(s.subscribers[id]) == 0 {
+		delete(s.subscribers, id)
+	}
+	close(ch)
+}
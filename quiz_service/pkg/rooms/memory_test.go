@@ -0,0 +1,93 @@
+package rooms
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestMemoryStoreUpdate(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	if err := store.Create(ctx, &Room{ID: "abcde", Phase: PhaseLobby}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Create(ctx, &Room{ID: "abcde"}); !errors.Is(err, ErrExists) {
+		t.Fatalf("expected ErrExists, got %v", err)
+	}
+
+	room, err := store.Update(ctx, "abcde", func(room *Room) error {
+		room.Players = append(room.Players, Player{Nickname: "bob", Answered: -1, Connected: true})
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Возвращенная копия не должна менять состояние в хранилище
+	room.Players[0].Score = 100
+
+	failed := errors.New("rejected")
+	if _, err = store.Update(ctx, "abcde", func(room *Room) error {
+		room.Phase = PhaseQuestion
+		return failed
+	}); !errors.Is(err, failed) {
+		t.Fatalf("expected the error of fn, got %v", err)
+	}
+
+	room, err = store.Get(ctx, "abcde")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if room.Phase != PhaseLobby || len(room.Players) != 1 || room.Players[0].Score != 0 {
+		t.Fatalf("unexpected room state: %+v", room)
+	}
+	if _, err = store.Update(ctx, "zzzzz", func(*Room) error { return nil }); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestMemoryStoreEvents(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	events, cancel, err := store.Subscribe(ctx, "abcde")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, cancelOther, _ := store.Subscribe(ctx, "fghij")
+	defer cancelOther()
+
+	for _, event := range []string{"one", "two"} {
+		if err = store.Publish(ctx, "abcde", []byte(event)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := string(<-events) + string(<-events); got != "onetwo" {
+		t.Fatalf("events out of order: %s", got)
+	}
+	if len(other) != 0 {
+		t.Fatal("event leaked into another room")
+	}
+
+	cancel()
+	if _, ok := <-events; ok {
+		t.Fatal("channel must be closed after cancel")
+	}
+	cancel()
+}
+
+func TestMemoryStoreDropsSlowSubscriber(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	events, cancel, _ := store.Subscribe(ctx, "abcde")
+	defer cancel()
+	for range subscriberBuffer + 1 {
+		store.Publish(ctx, "abcde", []byte("event"))
+	}
+	received := 0
+	for range events {
+		received++
+	}
+	if received != subscriberBuffer {
+		t.Fatalf("expected %d buffered events before the channel closes, got %d", subscriberBuffer, received)
+	}
+}
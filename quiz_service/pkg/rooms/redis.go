@@ -0,0 +1,147 @@
+package rooms
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Сколько раз Update повторяет транзакцию, если комнату изменили параллельно
+const maxUpdateRetries = 20
+
+// RedisStore хранит комнаты в Redis под ключами room:<id> и рассылает события
+// через pub/sub, так что участники одной комнаты могут сидеть на разных экземплярах
+type RedisStore struct {
+	cli *redis.Client
+}
+
+func NewRedisStore(ctx context.Context, config Config) (*RedisStore, error) {
+	cli := redis.NewClient(&redis.Options{
+		Addr:     config.RedisAddr,
+		Password: config.RedisPassword,
+		DB:       config.RedisDB,
+	})
+	if err := cli.Ping(ctx).Err(); err != nil {
+		return nil, fmt.Errorf("unable to connect to redis: %w", err)
+	}
+	return &RedisStore{cli: cli}, nil
+}
+
+func roomKey(id string) string {
+	return "room:" + id
+}
+
+func eventsChannel(id string) string {
+	return "room:" + id + ":events"
+}
+
+func (s *RedisStore) Create(ctx context.Context, room *Room) error {
+	data, err := json.Marshal(room)
+	if err != nil {
+		return fmt.Errorf("failed to encode room: %w", err)
+	}
+	created, err := s.cli.SetNX(ctx, roomKey(room.ID), data, TTL).Result()
+	if err != nil {
+		return fmt.Errorf("failed to create room: %w", err)
+	}
+	if !created {
+		return ErrExists
+	}
+	return nil
+}
+
+func (s *RedisStore) Get(ctx context.Context, id string) (*Room, error) {
+	return s.get(ctx, s.cli, id)
+}
+
+func (s *RedisStore) get(ctx context.Context, cmd redis.Cmdable, id string) (*Room, error) {
+	data, err := cmd.Get(ctx, roomKey(id)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get room: %w", err)
+	}
+	var room Room
+	if err = json.Unmarshal(data, &room); err != nil {
+		return nil, fmt.Errorf("failed to decode room: %w", err)
+	}
+	return &room, nil
+}
+
+// Update читает комнату под WATCH и записывает ее в MULTI, при параллельном
+// изменении транзакция повторяется со свежим состоянием
+func (s *RedisStore) Update(ctx context.Context, id string, fn func(*Room) error) (*Room, error) {
+	key := roomKey(id)
+	var updated *Room
+	txf := func(tx *redis.Tx) error {
+		room, err := s.get(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if err = fn(room); err != nil {
+			return err
+		}
+		data, err := json.Marshal(room)
+		if err != nil {
+			return fmt.Errorf("failed to encode room: %w", err)
+		}
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, key, data, TTL)
+			return nil
+		})
+		updated = room
+		return err
+	}
+	for range maxUpdateRetries {
+		err := s.cli.Watch(ctx, txf, key)
+		if errors.Is(err, redis.TxFailedErr) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return updated, nil
+	}
+	return nil, ErrConflict
+}
+
+func (s *RedisStore) Delete(ctx context.Context, id string) error {
+	if err := s.cli.Del(ctx, roomKey(id)).Err(); err != nil {
+		return fmt.Errorf("failed to delete room: %w", err)
+	}
+	return nil
+}
+
+func (s *RedisStore) Publish(ctx context.Context, id string, event []byte) error {
+	if err := s.cli.Publish(ctx, eventsChannel(id), event).Err(); err != nil {
+		return fmt.Errorf("failed to publish room event: %w", err)
+	}
+	return nil
+}
+
+func (s *RedisStore) Subscribe(ctx context.Context, id string) (<-chan []byte, func(), error) {
+	pubsub := s.cli.Subscribe(ctx, eventsChannel(id))
+	// Подписка должна быть подтверждена до возврата, иначе первые события потеряются
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return nil, nil, fmt.Errorf("failed to subscribe to room: %w", err)
+	}
+	messages := pubsub.Channel(redis.WithChannelSize(subscriberBuffer))
+	events := make(chan []byte, subscriberBuffer)
+	go func() {
+		defer close(events)
+		for msg := range messages {
+			select {
+			case events <- []byte(msg.Payload):
+			default:
+				pubsub.Close()
+				return
+			}
+		}
+	}()
+	return events, func() { pubsub.Close() }, nil
+}
@@ -0,0 +1,106 @@
+package rooms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+type Config struct {
+	Backend       string `yaml:"ROOMS_BACKEND" env:"ROOMS_BACKEND" env-default:"memory"`
+	RedisAddr     string `yaml:"ROOMS_REDIS_ADDR" env:"ROOMS_REDIS_ADDR" env-default:"redis_container:6379"`
+	RedisPassword string `yaml:"ROOMS_REDIS_PASSWORD" env:"ROOMS_REDIS_PASSWORD"`
+	RedisDB       int    `yaml:"ROOMS_REDIS_DB" env:"ROOMS_REDIS_DB" env-default:"0"`
+}
+
+var (
+	ErrNotFound = errors.New("room not found")
+	ErrExists   = errors.New("room already exists")
+	ErrConflict = errors.New("room is being changed concurrently")
+)
+
+// TTL комнаты: брошенные комнаты пропадают сами, каждое изменение продлевает срок
+const TTL = 2 * time.Hour
+
+type Phase string
+
+const (
+	PhaseLobby    Phase = "lobby"
+	PhaseQuestion Phase = "question"
+	PhaseResults  Phase = "results"
+)
+
+// Room - состояние комнаты, общее для всех экземпляров сервиса
+type Room struct {
+	ID       string `json:"id"`
+	QuizID   string `json:"quiz_id"`
+	Revision int32  `json:"revision"`
+	Host     string `json:"host"`
+	// Момент открытия отличает комнату от переоткрытой позже под тем же ID
+	Opened time.Time `json:"opened"`
+	Phase  Phase     `json:"phase"`
+	// Номер текущего вопроса с нуля и момент, когда его показали
+	Question        int       `json:"question"`
+	QuestionStarted time.Time `json:"question_started"`
+	Players         []Player  `json:"players"`
+	// Названия команд, пусто в игре без команд
+	Teams       []string    `json:"teams,omitempty"`
+	TeamScoring TeamScoring `json:"team_scoring,omitempty"`
+}
+
+type Player struct {
+	Nickname   string `json:"nickname"`
+	Score      int32  `json:"score"`
+	Correct    int32  `json:"correct"`
+	LastPoints int32  `json:"last_points"`
+	// Номер вопроса, на который игрок уже ответил, -1 если ни на какой
+	Answered  int    `json:"answered"`
+	Connected bool   `json:"connected"`
+	Team      string `json:"team,omitempty"`
+}
+
+// Player возвращает игрока по нику или nil
+func (r *Room) Player(nickname string) *Player {
+	for i := range r.Players {
+		if r.Players[i].Nickname == nickname {
+			return &r.Players[i]
+		}
+	}
+	return nil
+}
+
+func (r *Room) clone() *Room {
+	c := *r
+	c.Players = append([]Player(nil), r.Players...)
+	c.Teams = append([]string(nil), r.Teams...)
+	return &c
+}
+
+// Store хранит комнаты и рассылает события их участникам. События - непрозрачные
+// байты, порядок доставки внутри одной комнаты сохраняется.
+type Store interface {
+	Create(ctx context.Context, room *Room) error
+	Get(ctx context.Context, id string) (*Room, error)
+	// Update применяет fn к свежему состоянию и сохраняет результат атомарно.
+	// Если fn вернула ошибку, комната не меняется.
+	Update(ctx context.Context, id string, fn func(*Room) error) (*Room, error)
+	Delete(ctx context.Context, id string) error
+	Publish(ctx context.Context, id string, event []byte) error
+	// Subscribe возвращает канал событий комнаты, он закрывается после cancel
+	// или если подписчик не успевает их читать
+	Subscribe(ctx context.Context, id string) (<-chan []byte, func(), error)
+}
+
+// New выбирает хранилище по конфигу: memory подходит для одного экземпляра сервиса,
+// redis позволяет игрокам одной комнаты подключаться к разным экземплярам
+func New(ctx context.Context, config Config) (Store, error) {
+	switch config.Backend {
+	case "", "memory":
+		return NewMemoryStore(), nil
+	case "redis":
+		return NewRedisStore(ctx, config)
+	default:
+		return nil, fmt.Errorf("unknown rooms backend %q", config.Backend)
+	}
+}
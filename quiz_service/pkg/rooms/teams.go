@@ -0,0 +1,80 @@
+package rooms
+
+import (
+	"slices"
+	"strings"
+)
+
+// TeamScoring - как очки игроков складываются в очки команды
+type TeamScoring string
+
+const (
+	// ScoringAverage не дает преимущества команде, в которой больше игроков
+	ScoringAverage TeamScoring = "average"
+	ScoringSum     TeamScoring = "sum"
+)
+
+// TeamStanding - место команды в таблице
+type TeamStanding struct {
+	Name    string
+	Score   float32
+	Rank    int32
+	Players int32
+}
+
+// SmallestTeam возвращает команду с наименьшим числом игроков, при равенстве - первую в списке
+func (r *Room) SmallestTeam() string {
+	counts := r.teamSizes()
+	smallest := ""
+	for _, team := range r.Teams {
+		if smallest == "" || counts[team] < counts[smallest] {
+			smallest = team
+		}
+	}
+	return smallest
+}
+
+func (r *Room) teamSizes() map[string]int32 {
+	counts := make(map[string]int32, len(r.Teams))
+	for _, player := range r.Players {
+		counts[player.Team]++
+	}
+	return counts
+}
+
+// TeamStandings считает очки команд и сортирует их, при равенстве очков место общее.
+// Ушедшие после начала игры игроки учитываются, пустые команды в таблицу не попадают.
+func (r *Room) TeamStandings() []TeamStanding {
+	counts := r.teamSizes()
+	totals := make(map[string]float32, len(r.Teams))
+	for _, player := range r.Players {
+		totals[player.Team] += float32(player.Score)
+	}
+	var standings []TeamStanding
+	for _, team := range r.Teams {
+		if counts[team] == 0 {
+			continue
+		}
+		score := totals[team]
+		if r.TeamScoring != ScoringSum {
+			score /= float32(counts[team])
+		}
+		standings = append(standings, TeamStanding{Name: team, Score: score, Players: counts[team]})
+	}
+	slices.SortStableFunc(standings, func(a, b TeamStanding) int {
+		switch {
+		case a.Score > b.Score:
+			return -1
+		case a.Score < b.Score:
+			return 1
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	for i := range standings {
+		standings[i].Rank = int32(i + 1)
+		if i > 0 && standings[i].Score == standings[i-1].Score {
+			standings[i].Rank = standings[i-1].Rank
+		}
+	}
+	return standings
+}
@@ -0,0 +1,53 @@
+package rooms
+
+import "testing"
+
+func TestSmallestTeam(t *testing.T) {
+	room := &Room{Teams: []string{"red", "blue", "green"}}
+	if team := room.SmallestTeam(); team != "red" {
+		t.Fatalf("expected the first team of an empty room, got %q", team)
+	}
+	room.Players = []Player{{Nickname: "a", Team: "red"}, {Nickname: "b", Team: "blue"}, {Nickname: "c", Team: "red"}}
+	if team := room.SmallestTeam(); team != "green" {
+		t.Fatalf("expected green, got %q", team)
+	}
+	if team := (&Room{}).SmallestTeam(); team != "" {
+		t.Fatalf("a room without teams has no team, got %q", team)
+	}
+}
+
+func TestTeamStandings(t *testing.T) {
+	players := []Player{
+		{Nickname: "a", Team: "red", Score: 900},
+		{Nickname: "b", Team: "red", Score: 300},
+		{Nickname: "c", Team: "blue", Score: 600},
+		{Nickname: "d", Team: "owls", Score: 600},
+	}
+	room := &Room{Teams: []string{"red", "blue", "owls", "empty"}, TeamScoring: ScoringAverage, Players: players}
+	want := []TeamStanding{
+		{Name: "blue", Score: 600, Rank: 1, Players: 1},
+		{Name: "owls", Score: 600, Rank: 1, Players: 1},
+		{Name: "red", Score: 600, Rank: 1, Players: 2},
+	}
+	assertStandings(t, room.TeamStandings(), want)
+
+	room.TeamScoring = ScoringSum
+	want = []TeamStanding{
+		{Name: "red", Score: 1200, Rank: 1, Players: 2},
+		{Name: "blue", Score: 600, Rank: 2, Players: 1},
+		{Name: "owls", Score: 600, Rank: 2, Players: 1},
+	}
+	assertStandings(t, room.TeamStandings(), want)
+}
+
+func assertStandings(t *testing.T, got, want []TeamStanding) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("expected %d teams, got %+v", len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("place %d: expected %+v, got %+v", i, want[i], got[i])
+		}
+	}
+}
@@ -11,11 +11,14 @@ import (
 	"quiz_app/internal/statistics/repository"
 	"quiz_app/internal/statistics/service"
 	api "quiz_app/pkg/api/v1"
+	authapi "quiz_app/pkg/authapi/v1"
 	"quiz_app/pkg/faults"
 	"quiz_app/pkg/logger"
+	quizapi "quiz_app/pkg/quizapi/v1"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 var (
@@ -67,6 +70,22 @@ func main() {
 	}
 	log.Info(ctx, "new repository created")
 
+	// Quiz service
+	quizConn, err := grpc.NewClient(cfg.QuizAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatal(ctx, fmt.Sprintf("failed to connect to quiz service: %v", zap.Error(err)))
+	}
+	defer quizConn.Close()
+	log.Info(ctx, "quiz service client created")
+
+	// Auth service
+	authConn, err := grpc.NewClient(cfg.AuthAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatal(ctx, fmt.Sprintf("failed to connect to auth service: %v", zap.Error(err)))
+	}
+	defer authConn.Close()
+	log.Info(ctx, "auth service client created")
+
 	// Server
 	server := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
@@ -76,7 +95,7 @@ func main() {
 	log.Info(ctx, "server started")
 
 	// Service
-	service := service.New(ctx, repo)
+	service := service.New(ctx, repo, quizapi.NewQuizServiceClient(quizConn), authapi.NewAuthServiceClient(authConn))
 
 	api.RegisterStatisticsServer(server, service)
 	log.Info(ctx, "gRPC service started")
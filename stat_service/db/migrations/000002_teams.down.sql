@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_teams_timestamp ON stats.teams;
+DROP TABLE IF EXISTS stats.teams;
+DROP TABLE IF EXISTS stats.team_results;
@@ -0,0 +1,37 @@
+-- Результаты команд по каждой игре (комната или командный вызов)
+CREATE TABLE IF NOT EXISTS stats.team_results (
+    id BIGSERIAL PRIMARY KEY,
+    source VARCHAR(32) NOT NULL,
+    source_id VARCHAR(255) NOT NULL,
+    quiz_id VARCHAR(255) NOT NULL,
+    owner VARCHAR(255) NOT NULL,
+    team_name VARCHAR(255) NOT NULL,
+    score FLOAT NOT NULL,
+    rank INTEGER NOT NULL,
+    num_teams INTEGER NOT NULL,
+    num_players INTEGER NOT NULL,
+    scoring VARCHAR(16) NOT NULL,
+    played_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    -- Повторная отправка результатов той же игры ничего не меняет
+    UNIQUE (source, source_id, owner, team_name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_team_results_team ON stats.team_results(owner, team_name, played_at DESC);
+
+-- Статистика команд (TeamStat), команда определяется владельцем и названием
+CREATE TABLE IF NOT EXISTS stats.teams (
+    owner VARCHAR(255) NOT NULL,
+    team_name VARCHAR(255) NOT NULL,
+    num_sessions INTEGER NOT NULL DEFAULT 0,
+    wins INTEGER NOT NULL DEFAULT 0,
+    avg_rank FLOAT NOT NULL DEFAULT 0,
+    best_score FLOAT NOT NULL DEFAULT 0,
+    avg_score FLOAT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (owner, team_name)
+);
+
+CREATE TRIGGER update_teams_timestamp
+BEFORE UPDATE ON stats.teams
+FOR EACH ROW EXECUTE FUNCTION stats.update_timestamp();
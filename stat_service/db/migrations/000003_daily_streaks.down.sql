@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS update_daily_streaks_timestamp ON stats.daily_streaks;
+DROP TABLE IF EXISTS stats.daily_streaks;
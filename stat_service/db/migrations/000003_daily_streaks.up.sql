@@ -0,0 +1,14 @@
+-- Серии дней, в которые игрок сыграл квиз дня (DailyStreak)
+CREATE TABLE IF NOT EXISTS stats.daily_streaks (
+    user_id VARCHAR(255) PRIMARY KEY,
+    current_streak INTEGER NOT NULL DEFAULT 0,
+    best_streak INTEGER NOT NULL DEFAULT 0,
+    days_played INTEGER NOT NULL DEFAULT 0,
+    last_date DATE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER update_daily_streaks_timestamp
+BEFORE UPDATE ON stats.daily_streaks
+FOR EACH ROW EXECUTE FUNCTION stats.update_timestamp();
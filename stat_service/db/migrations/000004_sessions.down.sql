@@ -0,0 +1,8 @@
+ALTER TABLE stats.players
+    DROP COLUMN IF EXISTS legacy_best_score,
+    DROP COLUMN IF EXISTS legacy_total_score,
+    DROP COLUMN IF EXISTS legacy_sessions;
+ALTER TABLE stats.quizzes
+    DROP COLUMN IF EXISTS legacy_rate_sum,
+    DROP COLUMN IF EXISTS legacy_sessions;
+DROP TABLE IF EXISTS stats.sessions;
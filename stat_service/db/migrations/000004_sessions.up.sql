@@ -0,0 +1,28 @@
+-- История сыгранных сессий, из нее выводятся stats.quizzes и stats.players
+CREATE TABLE IF NOT EXISTS stats.sessions (
+    id BIGSERIAL PRIMARY KEY,
+    session_id VARCHAR(255) NOT NULL,
+    quiz_id VARCHAR(255) NOT NULL,
+    author_id VARCHAR(255) NOT NULL,
+    player_id VARCHAR(255) NOT NULL,
+    score FLOAT NOT NULL,
+    quiz_rate FLOAT NOT NULL,
+    duration_ms BIGINT NOT NULL DEFAULT 0,
+    played_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_sessions_player ON stats.sessions(player_id, played_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_sessions_quiz ON stats.sessions(quiz_id, played_at DESC, id DESC);
+
+-- Сессии, сыгранные до появления истории, не восстановить, поэтому их итоги
+-- остаются базой, к которой прибавляется история
+ALTER TABLE stats.quizzes
+    ADD COLUMN IF NOT EXISTS legacy_sessions INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS legacy_rate_sum FLOAT NOT NULL DEFAULT 0;
+UPDATE stats.quizzes SET legacy_sessions = num_sessions, legacy_rate_sum = avg_rate * num_sessions;
+
+ALTER TABLE stats.players
+    ADD COLUMN IF NOT EXISTS legacy_sessions INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS legacy_total_score FLOAT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS legacy_best_score FLOAT NOT NULL DEFAULT 0;
+UPDATE stats.players SET legacy_sessions = num_sessions, legacy_total_score = total_score, legacy_best_score = best_score;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS stats.idx_sessions_session;
+DROP INDEX IF EXISTS stats.idx_sessions_idempotency_key;
+ALTER TABLE stats.sessions DROP COLUMN IF EXISTS idempotency_key;
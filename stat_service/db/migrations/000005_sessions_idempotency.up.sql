@@ -0,0 +1,41 @@
+-- Ключ идемпотентности UpdateStats, по умолчанию - id сессии
+ALTER TABLE stats.sessions ADD COLUMN IF NOT EXISTS idempotency_key VARCHAR(255);
+UPDATE stats.sessions SET idempotency_key = session_id WHERE idempotency_key IS NULL;
+
+-- Повторно учтенные сессии удаляются, статистика пересчитывается по очищенной истории
+DELETE FROM stats.sessions a USING stats.sessions b
+WHERE a.session_id = b.session_id AND a.id > b.id;
+
+UPDATE stats.quizzes SET
+    num_sessions = stats.quizzes.legacy_sessions + history.num_sessions,
+    avg_rate = (stats.quizzes.legacy_rate_sum + history.rate_sum) / GREATEST(stats.quizzes.legacy_sessions + history.num_sessions, 1)
+FROM (
+    SELECT quiz_id, COUNT(*) AS num_sessions, SUM(quiz_rate) AS rate_sum
+    FROM stats.sessions GROUP BY quiz_id
+) history
+WHERE history.quiz_id = stats.quizzes.quiz_id;
+
+UPDATE stats.authors SET
+    num_quizzes = author_stats.num_quizzes,
+    avg_quiz_rate = author_stats.avg_rate,
+    best_quiz_rate = author_stats.best_rate
+FROM (
+    SELECT author_id, COUNT(DISTINCT quiz_id) AS num_quizzes, AVG(avg_rate) AS avg_rate, MAX(avg_rate) AS best_rate
+    FROM stats.quizzes GROUP BY author_id
+) author_stats
+WHERE author_stats.author_id = stats.authors.user_id;
+
+UPDATE stats.players SET
+    total_score = stats.players.legacy_total_score + history.total_score,
+    best_score = GREATEST(stats.players.legacy_best_score, history.best_score),
+    avg_score = (stats.players.legacy_total_score + history.total_score) / GREATEST(stats.players.legacy_sessions + history.num_sessions, 1),
+    num_sessions = stats.players.legacy_sessions + history.num_sessions
+FROM (
+    SELECT player_id, COUNT(*) AS num_sessions, SUM(score) AS total_score, MAX(score) AS best_score
+    FROM stats.sessions GROUP BY player_id
+) history
+WHERE history.player_id = stats.players.user_id;
+
+ALTER TABLE stats.sessions ALTER COLUMN idempotency_key SET NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_sessions_idempotency_key ON stats.sessions(idempotency_key);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_sessions_session ON stats.sessions(session_id);
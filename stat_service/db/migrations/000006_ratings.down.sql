@@ -0,0 +1,5 @@
+ALTER TABLE stats.quizzes
+    DROP COLUMN IF EXISTS legacy_ratings,
+    DROP COLUMN IF EXISTS num_ratings;
+DROP TRIGGER IF EXISTS update_ratings_timestamp ON stats.ratings;
+DROP TABLE IF EXISTS stats.ratings;
@@ -0,0 +1,51 @@
+-- Оценки квизов: одна на пользователя, с необязательным отзывом
+CREATE TABLE IF NOT EXISTS stats.ratings (
+    quiz_id VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    rate FLOAT NOT NULL,
+    review TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (quiz_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_ratings_quiz ON stats.ratings(quiz_id, updated_at DESC, user_id DESC);
+
+CREATE TRIGGER update_ratings_timestamp
+BEFORE UPDATE ON stats.ratings
+FOR EACH ROW EXECUTE FUNCTION stats.update_timestamp();
+
+-- Оценки до появления истории сессий остаются базой, как и сами сессии
+ALTER TABLE stats.quizzes
+    ADD COLUMN IF NOT EXISTS num_ratings INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS legacy_ratings INTEGER NOT NULL DEFAULT 0;
+UPDATE stats.quizzes SET legacy_ratings = legacy_sessions;
+
+-- Из истории берется последняя оценка каждого игрока, авторы свои квизы не оценивают
+INSERT INTO stats.ratings (quiz_id, user_id, rate, created_at, updated_at)
+SELECT DISTINCT ON (quiz_id, player_id) quiz_id, player_id, quiz_rate, played_at, played_at
+FROM stats.sessions
+WHERE quiz_rate > 0 AND player_id <> author_id
+ORDER BY quiz_id, player_id, played_at DESC, id DESC
+ON CONFLICT DO NOTHING;
+
+UPDATE stats.quizzes SET
+    num_ratings = stats.quizzes.legacy_ratings + COALESCE(ratings.num_ratings, 0),
+    avg_rate = (stats.quizzes.legacy_rate_sum + COALESCE(ratings.rate_sum, 0)) /
+        GREATEST(stats.quizzes.legacy_ratings + COALESCE(ratings.num_ratings, 0), 1)
+FROM stats.quizzes quizzes
+LEFT JOIN (
+    SELECT quiz_id, COUNT(*) AS num_ratings, SUM(rate) AS rate_sum
+    FROM stats.ratings GROUP BY quiz_id
+) ratings ON ratings.quiz_id = quizzes.quiz_id
+WHERE quizzes.quiz_id = stats.quizzes.quiz_id;
+
+UPDATE stats.authors SET
+    num_quizzes = author_stats.num_quizzes,
+    avg_quiz_rate = author_stats.avg_rate,
+    best_quiz_rate = author_stats.best_rate
+FROM (
+    SELECT author_id, COUNT(DISTINCT quiz_id) AS num_quizzes, AVG(avg_rate) AS avg_rate, MAX(avg_rate) AS best_rate
+    FROM stats.quizzes GROUP BY author_id
+) author_stats
+WHERE author_stats.author_id = stats.authors.user_id;
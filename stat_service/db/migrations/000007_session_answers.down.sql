@@ -0,0 +1 @@
+DROP TABLE IF EXISTS stats.session_answers;
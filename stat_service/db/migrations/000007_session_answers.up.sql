@@ -0,0 +1,12 @@
+-- Ответы на вопросы в сессиях, из них считается статистика вопросов квиза
+CREATE TABLE IF NOT EXISTS stats.session_answers (
+    session_id VARCHAR(255) NOT NULL REFERENCES stats.sessions(session_id) ON DELETE CASCADE,
+    quiz_id VARCHAR(255) NOT NULL,
+    question_id VARCHAR(255) NOT NULL,
+    is_correct BOOLEAN NOT NULL,
+    elapsed_ms BIGINT NOT NULL DEFAULT 0,
+    wrong_answer_ids TEXT[] NOT NULL DEFAULT '{}',
+    PRIMARY KEY (session_id, question_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_session_answers_quiz ON stats.session_answers(quiz_id, question_id);
@@ -13,6 +13,8 @@ type Config struct {
 	Host     string          `yaml:"HOST" env:"HOST" env-default:"127.0.0.1"`
 	GRPCPort int             `yaml:"GRPC_PORT" env:"GRPC_PORT" env-default:"50051"`
 	HTTPPort int             `yaml:"HTTP_PORT" env:"HTTP_PORT" env-default:"8080"`
+	QuizAddr string          `yaml:"QUIZ_ADDR" env:"QUIZ_ADDR" env-default:"quiz_service:50053"`
+	AuthAddr string          `yaml:"AUTH_ADDR" env:"AUTH_ADDR" env-default:"auth_service:50052"`
 }
 
 func getProjectRoot() string {
@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	api "quiz_app/pkg/api/v1"
+)
+
+var ErrPeriod = errors.New("unknown leaderboard period")
+
+// quizPeriodQuery - статистика квизов с сессиями или оценками с момента $1:
+// сессии за период и оценки, поставленные или измененные за период
+const quizPeriodQuery = `
+	SELECT
+		stats.quizzes.quiz_id,
+		stats.quizzes.author_id,
+		COALESCE(sessions.num_sessions, 0) AS num_sessions,
+		COALESCE(ratings.avg_rate, 0) AS avg_rate,
+		COALESCE(ratings.num_ratings, 0) AS num_ratings
+	FROM stats.quizzes
+	LEFT JOIN (
+		SELECT quiz_id, COUNT(*)::int AS num_sessions
+		FROM stats.sessions
+		WHERE played_at >= $1
+		GROUP BY quiz_id
+	) AS sessions ON sessions.quiz_id = stats.quizzes.quiz_id
+	LEFT JOIN (
+		SELECT quiz_id, AVG(rate) AS avg_rate, COUNT(*)::int AS num_ratings
+		FROM stats.ratings
+		WHERE updated_at >= $1
+		GROUP BY quiz_id
+	) AS ratings ON ratings.quiz_id = stats.quizzes.quiz_id
+	WHERE sessions.quiz_id IS NOT NULL OR ratings.quiz_id IS NOT NULL
+	`
+
+// Leaderboard - параметры страницы ListQuizzes, ListPlayers и ListAuthors
+type Leaderboard struct {
+	// Начало периода, nil - за все время
+	Since     *time.Time
+	Ascending bool
+	PageSize  int32
+	PageToken string
+	// Пользователь, чье место возвращается вместе со страницей, пустой - без него
+	UserID string
+}
+
+// PeriodStart возвращает начало текущего дня, недели (с понедельника) или месяца по UTC,
+// nil для всего времени
+func PeriodStart(period api.LeaderboardPeriod, now time.Time) (*time.Time, error) {
+	y, m, d := now.UTC().Date()
+	start := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	switch period {
+	case api.LeaderboardPeriod_PERIOD_ALL_TIME:
+		return nil, nil
+	case api.LeaderboardPeriod_PERIOD_DAILY:
+	case api.LeaderboardPeriod_PERIOD_WEEKLY:
+		start = start.AddDate(0, 0, -(int(start.Weekday())+6)%7)
+	case api.LeaderboardPeriod_PERIOD_MONTHLY:
+		start = time.Date(y, m, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return nil, ErrPeriod
+	}
+	return &start, nil
+}
+
+// encodeOffsetToken запоминает, сколько мест таблицы уже показано
+func encodeOffsetToken(offset int32) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(int(offset))))
+}
+
+func decodeOffsetToken(token string) (int32, error) {
+	if token == "" {
+		return 0, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, ErrPageToken
+	}
+	offset, err := strconv.ParseInt(string(raw), 10, 32)
+	if err != nil || offset < 0 {
+		return 0, ErrPageToken
+	}
+	return int32(offset), nil
+}
+
+// args возвращает аргументы запросов таблицы: начало периода, если он задан, и extra
+func (b Leaderboard) args(extra ...any) []any {
+	var args []any
+	if b.Since != nil {
+		args = append(args, *b.Since)
+	}
+	return append(args, extra...)
+}
+
+// rankedQuery нумерует строки source по колонке order. Равные значения делят место.
+// Колонки строк - колонки source и место rank.
+func (b Leaderboard) rankedQuery(source string, order string) string {
+	direction := "DESC"
+	if b.Ascending {
+		direction = "ASC"
+	}
+	return fmt.Sprintf(`
+	WITH board AS (%s),
+	ranked AS (
+		SELECT board.*, (RANK() OVER (ORDER BY board.%s %s))::int AS rank
+		FROM board
+	)
+	`, source, order, direction)
+}
+
+// pageQuery возвращает запрос страницы таблицы и его аргументы. Порядок внутри места
+// задает колонка id. Запрашивается лишняя строка, она показывает, что за страницей есть продолжение.
+func (b Leaderboard) pageQuery(source string, order string, id string) (string, []any, int32, error) {
+	offset, err := decodeOffsetToken(b.PageToken)
+	if err != nil {
+		return "", nil, 0, err
+	}
+	args := b.args(b.PageSize+1, offset)
+	query := b.rankedQuery(source, order) + fmt.Sprintf(`SELECT * FROM ranked
+	ORDER BY ranked.rank, ranked.%s
+	LIMIT $%d OFFSET $%d;
+	`, id, len(args)-1, len(args))
+	return query, args, offset, nil
+}
+
+// ownQuery возвращает запрос мест пользователя b.UserID, он ищется в колонке column.
+// Места считаются по всей таблице, поэтому видны и за пределами страницы.
+func (b Leaderboard) ownQuery(source string, order string, id string, column string) (string, []any) {
+	args := b.args(b.UserID)
+	query := b.rankedQuery(source, order) + fmt.Sprintf(`SELECT * FROM ranked
+	WHERE ranked.%s = $%d
+	ORDER BY ranked.rank, ranked.%s;
+	`, column, len(args), id)
+	return query, args
+}
+
+// nextToken возвращает токен следующей страницы, если строк больше, чем помещается на страницу
+func (b Leaderboard) nextToken(offset int32, rows int) string {
+	if rows <= int(b.PageSize) {
+		return ""
+	}
+	return encodeOffsetToken(offset + b.PageSize)
+}
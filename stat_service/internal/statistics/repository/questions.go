@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+
+	api "quiz_app/pkg/api/v1"
+	quizapi "quiz_app/pkg/quizapi/v1"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Доля лучших и худших сессий, по которым считается индекс дискриминации
+const discriminationGroup = 0.27
+
+// recordAnswers записывает ответы сессии на вопросы в транзакции UpdateStats
+func recordAnswers(ctx context.Context, tx pgx.Tx, session_id string, quiz_id string, answers []*quizapi.SessionAnswerResult) error {
+	answer_ins_query := `
+	INSERT INTO stats.session_answers (session_id, quiz_id, question_id, is_correct, elapsed_ms, wrong_answer_ids)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	ON CONFLICT DO NOTHING;
+	`
+	for _, answer := range answers {
+		wrong_answer_ids := answer.GetWrongAnswerIds()
+		if wrong_answer_ids == nil {
+			wrong_answer_ids = []string{}
+		}
+		_, err := tx.Exec(ctx, answer_ins_query, session_id, quiz_id, answer.GetQuestionId(), answer.GetIsCorrect(),
+			answer.GetElapsedMs(), wrong_answer_ids)
+		if err != nil {
+			return fmt.Errorf("unable to record answer: %w", err)
+		}
+	}
+	return nil
+}
+
+// questionAnswer - ответ на вопрос вместе с баллом сессии, в которой он дан
+type questionAnswer struct {
+	question_id      string
+	session_id       string
+	score            float32
+	is_correct       bool
+	elapsed_ms       int64
+	wrong_answer_ids []string
+}
+
+// GetQuestionStats возвращает число сессий с ответами и статистику каждого вопроса квиза
+func (r *Repository) GetQuestionStats(ctx context.Context, quiz_id string) (int32, []*api.QuestionStat, error) {
+	answers_query := `
+	SELECT
+		stats.session_answers.question_id,
+		stats.session_answers.session_id,
+		stats.sessions.score,
+		stats.session_answers.is_correct,
+		stats.session_answers.elapsed_ms,
+		stats.session_answers.wrong_answer_ids
+	FROM stats.session_answers
+	JOIN stats.sessions ON stats.sessions.session_id = stats.session_answers.session_id
+	WHERE stats.session_answers.quiz_id = $1
+	ORDER BY stats.session_answers.question_id, stats.sessions.score DESC, stats.sessions.id;
+	`
+	rows, err := r.pg.Query(ctx, answers_query, quiz_id)
+	if err != nil {
+		return 0, nil, fmt.Errorf("unable to get answers: %w", err)
+	}
+	defer rows.Close()
+	var answers []questionAnswer
+	for rows.Next() {
+		var answer questionAnswer
+		err = rows.Scan(&answer.question_id, &answer.session_id, &answer.score, &answer.is_correct,
+			&answer.elapsed_ms, &answer.wrong_answer_ids)
+		if err != nil {
+			return 0, nil, fmt.Errorf("scan failed: %w", err)
+		}
+		answers = append(answers, answer)
+	}
+	if err = rows.Err(); err != nil {
+		return 0, nil, fmt.Errorf("unable to get answers: %w", err)
+	}
+	num_sessions, questions := questionStats(answers)
+	return num_sessions, questions, nil
+}
+
+// questionStats считает статистику вопросов по ответам, отсортированным по вопросу
+// и по убыванию балла сессии
+func questionStats(answers []questionAnswer) (int32, []*api.QuestionStat) {
+	sessions := map[string]bool{}
+	var questions []*api.QuestionStat
+	for start := 0; start < len(answers); {
+		end := start
+		for end < len(answers) && answers[end].question_id == answers[start].question_id {
+			sessions[answers[end].session_id] = true
+			end++
+		}
+		questions = append(questions, questionStat(answers[start:end]))
+		start = end
+	}
+	return int32(len(sessions)), questions
+}
+
+// questionStat считает статистику одного вопроса. Индекс дискриминации - разность долей
+// верных ответов в лучших и худших 27% сессий.
+func questionStat(answers []questionAnswer) *api.QuestionStat {
+	var (
+		num_correct int
+		total_ms    int64
+		picked      = map[string]int32{}
+	)
+	for _, answer := range answers {
+		if answer.is_correct {
+			num_correct++
+		}
+		total_ms += answer.elapsed_ms
+		for _, answer_id := range answer.wrong_answer_ids {
+			picked[answer_id]++
+		}
+	}
+	n := len(answers)
+	stat := &api.QuestionStat{
+		QuestionId:     answers[0].question_id,
+		NumAnswers:     int32(n),
+		PercentCorrect: float32(num_correct) * 100 / float32(n),
+		AvgTimeMs:      total_ms / int64(n),
+	}
+	for answer_id, num_picked := range picked {
+		stat.Distractors = append(stat.Distractors, &api.DistractorStat{
+			AnswerId:      answer_id,
+			NumPicked:     num_picked,
+			PercentPicked: float32(num_picked) * 100 / float32(n),
+		})
+	}
+	slices.SortFunc(stat.Distractors, func(a, b *api.DistractorStat) int {
+		return cmp.Or(cmp.Compare(b.NumPicked, a.NumPicked), cmp.Compare(a.AnswerId, b.AnswerId))
+	})
+	group := int(math.Round(discriminationGroup * float64(n)))
+	if group > 0 {
+		var upper, lower int
+		for i := 0; i < group; i++ {
+			if answers[i].is_correct {
+				upper++
+			}
+			if answers[n-1-i].is_correct {
+				lower++
+			}
+		}
+		stat.DiscriminationIndex = float32(upper-lower) / float32(group)
+	}
+	return stat
+}
@@ -2,11 +2,17 @@ package repository
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"quiz_app/internal/config"
 	api "quiz_app/pkg/api/v1"
 	"quiz_app/pkg/logger"
 	"quiz_app/pkg/postgres"
+	quizapi "quiz_app/pkg/quizapi/v1"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -14,7 +20,20 @@ import (
 	"go.uber.org/zap"
 )
 
+// Сколько последних игр команды возвращает GetTeamStat
+const teamHistoryLimit = 50
+
+// Сколько лучших команд возвращает ListTeams
+const teamsLimit = 100
+
+var (
+	ErrPageToken    = errors.New("invalid page token")
+	ErrQuizNotFound = errors.New("quiz has no statistics yet")
+	ErrOwnQuiz      = errors.New("authors can not rate their own quizzes")
+)
+
 type PGDatabase interface {
+	Begin(context.Context) (pgx.Tx, error)
 	Exec(context.Context, string, ...any) (pgconn.CommandTag, error)
 	Close()
 	QueryRow(context.Context, string, ...any) pgx.Row
@@ -53,30 +72,8 @@ func (r *Repository) CloseConn() {
 	r.pg.Close()
 }
 
-func (r *Repository) UpdateStats(
-	ctx context.Context,
-	quiz_id string,
-	author_id string,
-	player_id string,
-	player_score float32,
-	quiz_rate float32,
-) error {
-	quiz_upd_query := `
-	INSERT INTO stats.quizzes (quiz_id, author_id, avg_rate, num_sessions, updated_at)
-	VALUES (
-    	$2, 
-		$3,
-   		$1,  
-    	1,   
-    	CURRENT_TIMESTAMP
-	)
-	ON CONFLICT (quiz_id) DO UPDATE
-	SET
-    	avg_rate = (stats.quizzes.avg_rate * stats.quizzes.num_sessions + $1) / (stats.quizzes.num_sessions + 1),
-    	num_sessions = stats.quizzes.num_sessions + 1,
-    	updated_at = CURRENT_TIMESTAMP;
-	`
-	author_upd_query := `
+// authorStatsQuery пересчитывает статистику автора $1 по статистике его квизов
+const authorStatsQuery = `
 	WITH author_stats AS (
 		SELECT
 	    	COUNT(DISTINCT quiz_id) AS num_quizzes,
@@ -100,41 +97,382 @@ func (r *Repository) UpdateStats(
 		best_quiz_rate = EXCLUDED.best_quiz_rate,
 		updated_at = EXCLUDED.updated_at;
 	`
+
+// quizRatingQuery пересчитывает оценку квиза $1 по таблице оценок
+const quizRatingQuery = `
+	WITH ratings AS (
+		SELECT
+			COUNT(*) AS num_ratings,
+			COALESCE(SUM(rate), 0) AS rate_sum
+		FROM stats.ratings
+		WHERE quiz_id = $1
+	)
+	UPDATE stats.quizzes
+	SET
+		avg_rate = (stats.quizzes.legacy_rate_sum + ratings.rate_sum) /
+			GREATEST(stats.quizzes.legacy_ratings + ratings.num_ratings, 1),
+		num_ratings = stats.quizzes.legacy_ratings + ratings.num_ratings,
+		updated_at = CURRENT_TIMESTAMP
+	FROM ratings
+	WHERE stats.quizzes.quiz_id = $1
+	RETURNING stats.quizzes.author_id, stats.quizzes.num_sessions, stats.quizzes.avg_rate, stats.quizzes.num_ratings;
+	`
+
+// UpdateStats записывает сессию в историю и пересчитывает по ней статистику квиза, автора и игрока
+// в одной транзакции. Оценка квиза считается по таблице оценок. Сессия учитывается один раз: при повторе ключа или сессии ничего не меняется
+// и возвращается записанная в первый раз сессия с флагом повтора. Ответы на вопросы записываются вместе с сессией.
+func (r *Repository) UpdateStats(ctx context.Context, key string, session *api.PlayedSession, answers []*quizapi.SessionAnswerResult) (*api.PlayedSession, bool, error) {
+	// Пересчеты одного квиза или игрока идут по очереди, иначе параллельная транзакция
+	// не увидит чужую сессию и запишет неполную статистику
+	lock_query := `
+	SELECT pg_advisory_xact_lock(hashtext('stats.quizzes:' || $1)), pg_advisory_xact_lock(hashtext('stats.players:' || $2));
+	`
+	session_ins_query := `
+	INSERT INTO stats.sessions (idempotency_key, session_id, quiz_id, author_id, player_id, score, quiz_rate, duration_ms, played_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	ON CONFLICT DO NOTHING;
+	`
+	recorded_query := `
+	SELECT
+		stats.sessions.session_id,
+		stats.sessions.quiz_id,
+		stats.sessions.author_id,
+		stats.sessions.player_id,
+		stats.sessions.score,
+		stats.sessions.quiz_rate,
+		stats.sessions.duration_ms,
+		stats.sessions.played_at
+	FROM stats.sessions
+	WHERE stats.sessions.idempotency_key = $1 OR stats.sessions.session_id = $2
+	ORDER BY stats.sessions.id
+	LIMIT 1;
+	`
+	quiz_upd_query := `
+	WITH history AS (
+		SELECT COUNT(*) AS num_sessions
+		FROM stats.sessions
+		WHERE quiz_id = $1
+	)
+	INSERT INTO stats.quizzes (quiz_id, author_id, num_sessions, updated_at)
+	SELECT $1, $2, history.num_sessions, CURRENT_TIMESTAMP
+	FROM history
+	ON CONFLICT (quiz_id) DO UPDATE
+	SET
+		num_sessions = stats.quizzes.legacy_sessions + EXCLUDED.num_sessions,
+		updated_at = CURRENT_TIMESTAMP;
+	`
 	player_upd_query := `
-	INSERT INTO stats.players (user_id, total_score, best_score, avg_score, num_sessions, updated_at)
-	VALUES (
-    	$2,
-    	$1,  
-    	$1,  
-    	$1,  
-    	1,   
-    	CURRENT_TIMESTAMP
+	WITH history AS (
+		SELECT
+			COUNT(*) AS num_sessions,
+			COALESCE(SUM(score), 0) AS total_score,
+			COALESCE(MAX(score), 0) AS best_score
+		FROM stats.sessions
+		WHERE player_id = $1
 	)
+	INSERT INTO stats.players (user_id, total_score, best_score, avg_score, num_sessions, updated_at)
+	SELECT $1, history.total_score, history.best_score, history.total_score / GREATEST(history.num_sessions, 1),
+		history.num_sessions, CURRENT_TIMESTAMP
+	FROM history
 	ON CONFLICT (user_id) DO UPDATE
 	SET
-    	total_score = stats.players.total_score + $1,
-    	best_score = GREATEST(stats.players.best_score, $1),
-    	avg_score = (stats.players.avg_score * stats.players.num_sessions + $1) / (stats.players.num_sessions + 1),
-    	num_sessions = stats.players.num_sessions + 1,
-    	updated_at = CURRENT_TIMESTAMP;
+		total_score = stats.players.legacy_total_score + EXCLUDED.total_score,
+		best_score = GREATEST(stats.players.legacy_best_score, EXCLUDED.best_score),
+		avg_score = (stats.players.legacy_total_score + EXCLUDED.total_score) /
+			GREATEST(stats.players.legacy_sessions + EXCLUDED.num_sessions, 1),
+		num_sessions = stats.players.legacy_sessions + EXCLUDED.num_sessions,
+		updated_at = CURRENT_TIMESTAMP;
 	`
-	if (len(quiz_id) == 0) || (len(author_id) == 0) || (len(player_id) == 0) || (player_score < 0) || (quiz_rate < 0) || (quiz_rate > 5) {
-		return fmt.Errorf("wrong request format")
+	quiz_id, author_id, player_id := session.GetQuizId(), session.GetAuthorId(), session.GetPlayerId()
+	player_score, quiz_rate := session.GetScore(), session.GetQuizRate()
+	played_at, err := time.Parse(time.RFC3339Nano, session.GetPlayedAt())
+	if (len(key) == 0) || (len(session.GetSessionId()) == 0) || (len(quiz_id) == 0) || (len(author_id) == 0) || (len(player_id) == 0) ||
+		(player_score < 0) || (quiz_rate < 0) || (quiz_rate > 5) || (session.GetDurationMs() < 0) || (err != nil) {
+		return nil, false, fmt.Errorf("wrong request format")
+	}
+	for _, answer := range answers {
+		if (len(answer.GetQuestionId()) == 0) || (answer.GetElapsedMs() < 0) {
+			return nil, false, fmt.Errorf("wrong request format")
+		}
 	}
-	_, err := r.pg.Exec(ctx, quiz_upd_query, quiz_rate, quiz_id, author_id)
+	tx, err := r.pg.Begin(ctx)
 	if err != nil {
-		return fmt.Errorf("unable to update quiz statistics: %w", err)
+		return nil, false, fmt.Errorf("unable to begin transaction: %w", err)
 	}
-	_, err = r.pg.Exec(ctx, author_upd_query, author_id)
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, lock_query, quiz_id, player_id)
 	if err != nil {
-		return fmt.Errorf("unable to update author statistics: %w", err)
+		return nil, false, fmt.Errorf("unable to lock statistics: %w", err)
 	}
-	_, err = r.pg.Exec(ctx, player_upd_query, player_score, player_id)
+	tag, err := tx.Exec(ctx, session_ins_query, key, session.GetSessionId(), quiz_id, author_id, player_id,
+		player_score, quiz_rate, session.GetDurationMs(), played_at)
 	if err != nil {
-		return fmt.Errorf("unable to update player statistics: %w", err)
+		return nil, false, fmt.Errorf("unable to record session: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		recorded := &api.PlayedSession{}
+		err = tx.QueryRow(ctx, recorded_query, key, session.GetSessionId()).Scan(&recorded.SessionId, &recorded.QuizId,
+			&recorded.AuthorId, &recorded.PlayerId, &recorded.Score, &recorded.QuizRate, &recorded.DurationMs, &played_at)
+		if err != nil {
+			return nil, false, fmt.Errorf("unable to get recorded session: %w", err)
+		}
+		recorded.PlayedAt = played_at.UTC().Format(time.RFC3339Nano)
+		return recorded, true, nil
+	}
+	err = recordAnswers(ctx, tx, session.GetSessionId(), quiz_id, answers)
+	if err != nil {
+		return nil, false, err
+	}
+	_, err = tx.Exec(ctx, quiz_upd_query, quiz_id, author_id)
+	if err != nil {
+		return nil, false, fmt.Errorf("unable to update quiz statistics: %w", err)
+	}
+	_, err = tx.Exec(ctx, quizRatingQuery, quiz_id)
+	if err != nil {
+		return nil, false, fmt.Errorf("unable to update quiz rating: %w", err)
+	}
+	_, err = tx.Exec(ctx, authorStatsQuery, author_id)
+	if err != nil {
+		return nil, false, fmt.Errorf("unable to update author statistics: %w", err)
+	}
+	_, err = tx.Exec(ctx, player_upd_query, player_id)
+	if err != nil {
+		return nil, false, fmt.Errorf("unable to update player statistics: %w", err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return nil, false, fmt.Errorf("unable to commit statistics: %w", err)
 	}
 
-	return nil
+	return session, false, nil
+}
+
+// encodeSessionsToken запоминает последнюю сессию страницы для keyset-пагинации
+func encodeSessionsToken(played_at time.Time, id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%d", played_at.UnixMicro(), id)))
+}
+
+func decodeSessionsToken(token string) (*time.Time, int64, error) {
+	if token == "" {
+		return nil, 0, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, 0, ErrPageToken
+	}
+	var micros, id int64
+	if _, err := fmt.Sscanf(string(raw), "%d:%d", &micros, &id); err != nil {
+		return nil, 0, ErrPageToken
+	}
+	played_at := time.UnixMicro(micros)
+	return &played_at, id, nil
+}
+
+// ListPlayerSessions возвращает страницу сессий игрока, новые первыми
+func (r *Repository) ListPlayerSessions(ctx context.Context, user_id string, page_size int32, page_token string) ([]*api.PlayedSession, string, error) {
+	return r.listSessions(ctx, "player_id", user_id, page_size, page_token)
+}
+
+// ListQuizSessions возвращает страницу сессий квиза, новые первыми
+func (r *Repository) ListQuizSessions(ctx context.Context, quiz_id string, page_size int32, page_token string) ([]*api.PlayedSession, string, error) {
+	return r.listSessions(ctx, "quiz_id", quiz_id, page_size, page_token)
+}
+
+func (r *Repository) listSessions(ctx context.Context, column string, value string, page_size int32, page_token string) ([]*api.PlayedSession, string, error) {
+	sessions_query := fmt.Sprintf(`
+	SELECT
+		stats.sessions.id,
+		stats.sessions.session_id,
+		stats.sessions.quiz_id,
+		stats.sessions.author_id,
+		stats.sessions.player_id,
+		stats.sessions.score,
+		stats.sessions.quiz_rate,
+		stats.sessions.duration_ms,
+		stats.sessions.played_at
+	FROM stats.sessions
+	WHERE stats.sessions.%s = $1
+		AND ($2::timestamptz IS NULL OR (stats.sessions.played_at, stats.sessions.id) < ($2, $3))
+	ORDER BY stats.sessions.played_at DESC, stats.sessions.id DESC
+	LIMIT $4;
+	`, column)
+	after, after_id, err := decodeSessionsToken(page_token)
+	if err != nil {
+		return nil, "", err
+	}
+	// Лишняя строка показывает, что за страницей есть продолжение
+	rows, err := r.pg.Query(ctx, sessions_query, value, after, after_id, page_size+1)
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to list sessions: %w", err)
+	}
+	defer rows.Close()
+	var (
+		sessions   []*api.PlayedSession
+		next_token string
+		last_id    int64
+		last_at    time.Time
+	)
+	for rows.Next() {
+		if len(sessions) == int(page_size) {
+			next_token = encodeSessionsToken(last_at, last_id)
+			break
+		}
+		var session api.PlayedSession
+		err = rows.Scan(&last_id, &session.SessionId, &session.QuizId, &session.AuthorId, &session.PlayerId,
+			&session.Score, &session.QuizRate, &session.DurationMs, &last_at)
+		if err != nil {
+			return nil, "", fmt.Errorf("scan failed: %w", err)
+		}
+		session.PlayedAt = last_at.UTC().Format(time.RFC3339Nano)
+		sessions = append(sessions, &session)
+	}
+	return sessions, next_token, rows.Err()
+}
+
+// RateQuiz сохраняет оценку пользователя, заменяя прежнюю, и пересчитывает оценку квиза и статистику автора.
+// Квиз, который еще никто не доиграл, заводится в статистике с автором author_id.
+func (r *Repository) RateQuiz(ctx context.Context, quiz_id string, author_id string, user_id string, rate float32, review string) (*api.QuizRating, *api.QuizStat, error) {
+	lock_query := `
+	SELECT pg_advisory_xact_lock(hashtext('stats.quizzes:' || $1));
+	`
+	quiz_ins_query := `
+	INSERT INTO stats.quizzes (quiz_id, author_id)
+	VALUES ($1, $2)
+	ON CONFLICT (quiz_id) DO NOTHING;
+	`
+	author_query := `
+	SELECT stats.quizzes.author_id
+	FROM stats.quizzes
+	WHERE stats.quizzes.quiz_id = $1;
+	`
+	rating_upd_query := `
+	INSERT INTO stats.ratings (quiz_id, user_id, rate, review)
+	VALUES ($1, $2, $3, $4)
+	ON CONFLICT (quiz_id, user_id) DO UPDATE
+	SET
+		rate = EXCLUDED.rate,
+		review = EXCLUDED.review
+	RETURNING stats.ratings.updated_at;
+	`
+	if (len(quiz_id) == 0) || (len(author_id) == 0) || (len(user_id) == 0) || (rate < 1) || (rate > 5) {
+		return nil, nil, fmt.Errorf("wrong request format")
+	}
+	tx, err := r.pg.Begin(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, lock_query, quiz_id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to lock statistics: %w", err)
+	}
+	_, err = tx.Exec(ctx, quiz_ins_query, quiz_id, author_id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to add quiz statistics: %w", err)
+	}
+	// Автор уже записанного квиза берется из статистики
+	err = tx.QueryRow(ctx, author_query, quiz_id).Scan(&author_id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get quiz statistics: %w", err)
+	}
+	if author_id == user_id {
+		return nil, nil, ErrOwnQuiz
+	}
+	var updated_at time.Time
+	err = tx.QueryRow(ctx, rating_upd_query, quiz_id, user_id, rate, review).Scan(&updated_at)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to save rating: %w", err)
+	}
+	quiz := &api.QuizStat{QuizId: quiz_id}
+	err = tx.QueryRow(ctx, quizRatingQuery, quiz_id).Scan(&quiz.AuthorId, &quiz.NumSessions, &quiz.AvgRate, &quiz.NumRatings)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to update quiz rating: %w", err)
+	}
+	_, err = tx.Exec(ctx, authorStatsQuery, author_id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to update author statistics: %w", err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return nil, nil, fmt.Errorf("unable to commit rating: %w", err)
+	}
+	rating := &api.QuizRating{
+		QuizId:    quiz_id,
+		UserId:    user_id,
+		Rate:      rate,
+		Review:    review,
+		UpdatedAt: updated_at.UTC().Format(time.RFC3339Nano),
+	}
+	return rating, quiz, nil
+}
+
+// encodeRatingsToken запоминает последнюю оценку страницы для keyset-пагинации
+func encodeRatingsToken(updated_at time.Time, user_id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%s", updated_at.UnixMicro(), user_id)))
+}
+
+func decodeRatingsToken(token string) (*time.Time, string, error) {
+	if token == "" {
+		return nil, "", nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, "", ErrPageToken
+	}
+	micros, user_id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return nil, "", ErrPageToken
+	}
+	us, err := strconv.ParseInt(micros, 10, 64)
+	if err != nil {
+		return nil, "", ErrPageToken
+	}
+	updated_at := time.UnixMicro(us)
+	return &updated_at, user_id, nil
+}
+
+// ListQuizRatings возвращает страницу оценок квиза, последние измененные первыми
+func (r *Repository) ListQuizRatings(ctx context.Context, quiz_id string, page_size int32, page_token string) ([]*api.QuizRating, string, error) {
+	ratings_query := `
+	SELECT
+		stats.ratings.user_id,
+		stats.ratings.rate,
+		stats.ratings.review,
+		stats.ratings.updated_at
+	FROM stats.ratings
+	WHERE stats.ratings.quiz_id = $1
+		AND ($2::timestamptz IS NULL OR (stats.ratings.updated_at, stats.ratings.user_id) < ($2, $3))
+	ORDER BY stats.ratings.updated_at DESC, stats.ratings.user_id DESC
+	LIMIT $4;
+	`
+	after, after_user, err := decodeRatingsToken(page_token)
+	if err != nil {
+		return nil, "", err
+	}
+	rows, err := r.pg.Query(ctx, ratings_query, quiz_id, after, after_user, page_size+1)
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to list ratings: %w", err)
+	}
+	defer rows.Close()
+	var (
+		ratings    []*api.QuizRating
+		next_token string
+		last_at    time.Time
+	)
+	for rows.Next() {
+		if len(ratings) == int(page_size) {
+			next_token = encodeRatingsToken(last_at, ratings[len(ratings)-1].UserId)
+			break
+		}
+		rating := api.QuizRating{QuizId: quiz_id}
+		err = rows.Scan(&rating.UserId, &rating.Rate, &rating.Review, &last_at)
+		if err != nil {
+			return nil, "", fmt.Errorf("scan failed: %w", err)
+		}
+		rating.UpdatedAt = last_at.UTC().Format(time.RFC3339Nano)
+		ratings = append(ratings, &rating)
+	}
+	return ratings, next_token, rows.Err()
 }
 
 func (r *Repository) GetQuizStat(ctx context.Context, quiz_id string) (*api.QuizStat, error) {
@@ -142,7 +480,8 @@ func (r *Repository) GetQuizStat(ctx context.Context, quiz_id string) (*api.Quiz
 	SELECT 
 		stats.quizzes.author_id, 
 		stats.quizzes.num_sessions, 
-		stats.quizzes.avg_rate
+		stats.quizzes.avg_rate,
+		stats.quizzes.num_ratings
 	FROM stats.quizzes
 	WHERE stats.quizzes.quiz_id = $1;
 	`
@@ -150,8 +489,9 @@ func (r *Repository) GetQuizStat(ctx context.Context, quiz_id string) (*api.Quiz
 		author_id    string
 		num_sessions int32
 		avg_rate     float32
+		num_ratings  int32
 	)
-	err := r.pg.QueryRow(ctx, quiz_stat_query, quiz_id).Scan(&author_id, &num_sessions, &avg_rate)
+	err := r.pg.QueryRow(ctx, quiz_stat_query, quiz_id).Scan(&author_id, &num_sessions, &avg_rate, &num_ratings)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return &api.QuizStat{
@@ -168,10 +508,12 @@ func (r *Repository) GetQuizStat(ctx context.Context, quiz_id string) (*api.Quiz
 		AuthorId:    author_id,
 		NumSessions: num_sessions,
 		AvgRate:     avg_rate,
+		NumRatings:  num_ratings,
 	}, nil
 }
 
-func (r *Repository) ListQuizzes(ctx context.Context, option api.ListQuizzesOption) ([]*api.QuizStat, error) {
+// ListQuizzes возвращает страницу таблицы квизов и места квизов пользователя board.UserID
+func (r *Repository) ListQuizzes(ctx context.Context, option api.ListQuizzesOption, board Leaderboard) ([]*api.QuizStat, []*api.QuizStat, string, error) {
 	var order string
 	switch option {
 	case api.ListQuizzesOption_AVG_RATE:
@@ -179,43 +521,61 @@ func (r *Repository) ListQuizzes(ctx context.Context, option api.ListQuizzesOpti
 	case api.ListQuizzesOption_NUM_SESSIONS:
 		order = "num_sessions"
 	default:
-		return nil, fmt.Errorf("no such option: %d", option)
+		return nil, nil, "", fmt.Errorf("no such option: %d", option)
 	}
-	list_query := fmt.Sprintf(`
-	SELECT 
+	source := `
+	SELECT
 		stats.quizzes.quiz_id,
 		stats.quizzes.author_id,
 		stats.quizzes.num_sessions,
-		stats.quizzes.avg_rate
+		stats.quizzes.avg_rate,
+		stats.quizzes.num_ratings
 	FROM stats.quizzes
-	ORDER BY stats.quizzes.%s;
-	`, order)
-	rows, err := r.pg.Query(ctx, list_query)
+	`
+	if board.Since != nil {
+		source = quizPeriodQuery
+	}
+	list_query, args, offset, err := board.pageQuery(source, order, "quiz_id")
+	if err != nil {
+		return nil, nil, "", err
+	}
+	rows, err := r.pg.Query(ctx, list_query, args...)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("unable to list quizzes: %w", err)
+	}
+	results, err := scanQuizzes(rows)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	next_token := board.nextToken(offset, len(results))
+	results = results[:min(len(results), int(board.PageSize))]
+	if board.UserID == "" {
+		return results, nil, next_token, nil
+	}
+	own_query, args := board.ownQuery(source, order, "quiz_id", "author_id")
+	rows, err = r.pg.Query(ctx, own_query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("unable to list quizzes: %w", err)
+		return nil, nil, "", fmt.Errorf("unable to list own quizzes: %w", err)
 	}
+	mine, err := scanQuizzes(rows)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	return results, mine, next_token, nil
+}
+
+func scanQuizzes(rows pgx.Rows) ([]*api.QuizStat, error) {
 	defer rows.Close()
 	var results []*api.QuizStat
 	for rows.Next() {
-		var (
-			quiz_id      string
-			author_id    string
-			num_sessions int32
-			avg_rate     float32
-		)
-		err = rows.Scan(&quiz_id, &author_id, &num_sessions, &avg_rate)
+		var quiz api.QuizStat
+		err := rows.Scan(&quiz.QuizId, &quiz.AuthorId, &quiz.NumSessions, &quiz.AvgRate, &quiz.NumRatings, &quiz.Rank)
 		if err != nil {
 			return nil, fmt.Errorf("scan failed: %w", err)
 		}
-		results = append(results,
-			&api.QuizStat{
-				QuizId:      quiz_id,
-				AuthorId:    author_id,
-				NumSessions: num_sessions,
-				AvgRate:     avg_rate,
-			})
+		results = append(results, &quiz)
 	}
-	return results, nil
+	return results, rows.Err()
 }
 
 func (r *Repository) GetPlayerStat(ctx context.Context, user_id string) (*api.PlayerStat, error) {
@@ -257,7 +617,8 @@ func (r *Repository) GetPlayerStat(ctx context.Context, user_id string) (*api.Pl
 	}, nil
 }
 
-func (r *Repository) ListPlayers(ctx context.Context, option api.ListPlayersOption) ([]*api.PlayerStat, error) {
+// ListPlayers возвращает страницу таблицы игроков и место пользователя board.UserID
+func (r *Repository) ListPlayers(ctx context.Context, option api.ListPlayersOption, board Leaderboard) ([]*api.PlayerStat, *api.PlayerStat, string, error) {
 	var order string
 	switch option {
 	case api.ListPlayersOption_TOTAL_SCORE:
@@ -267,46 +628,66 @@ func (r *Repository) ListPlayers(ctx context.Context, option api.ListPlayersOpti
 	case api.ListPlayersOption_AVG_SCORE:
 		order = "avg_score"
 	default:
-		return nil, fmt.Errorf("no such option: %d", option)
+		return nil, nil, "", fmt.Errorf("no such option: %d", option)
 	}
-	list_query := fmt.Sprintf(`
-	SELECT 
+	source := `
+	SELECT
 		stats.players.user_id,
 		stats.players.total_score,
 		stats.players.best_score,
 		stats.players.avg_score,
 		stats.players.num_sessions
 	FROM stats.players
-	ORDER BY stats.players.%s;
-	`, order)
-	rows, err := r.pg.Query(ctx, list_query)
+	`
+	if board.Since != nil {
+		source = `
+	SELECT
+		stats.sessions.player_id AS user_id,
+		SUM(stats.sessions.score) AS total_score,
+		MAX(stats.sessions.score) AS best_score,
+		AVG(stats.sessions.score) AS avg_score,
+		COUNT(*)::int AS num_sessions
+	FROM stats.sessions
+	WHERE stats.sessions.played_at >= $1
+	GROUP BY stats.sessions.player_id
+	`
+	}
+	list_query, args, offset, err := board.pageQuery(source, order, "user_id")
+	if err != nil {
+		return nil, nil, "", err
+	}
+	rows, err := r.pg.Query(ctx, list_query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("unable to list players: %w", err)
+		return nil, nil, "", fmt.Errorf("unable to list players: %w", err)
 	}
 	defer rows.Close()
 	var results []*api.PlayerStat
 	for rows.Next() {
-		var (
-			user_id      string
-			total_score  float32
-			best_score   float32
-			avg_score    float32
-			num_sessions int32
-		)
-		err = rows.Scan(&user_id, &total_score, &best_score, &avg_score, &num_sessions)
+		var player api.PlayerStat
+		err = rows.Scan(&player.UserId, &player.TotalScore, &player.BestScore, &player.AvgScore, &player.NumSessions, &player.Rank)
 		if err != nil {
-			return nil, fmt.Errorf("scan failed: %w", err)
+			return nil, nil, "", fmt.Errorf("scan failed: %w", err)
 		}
-		results = append(results,
-			&api.PlayerStat{
-				UserId:      user_id,
-				TotalScore:  total_score,
-				BestScore:   best_score,
-				AvgScore:    avg_score,
-				NumSessions: num_sessions,
-			})
+		results = append(results, &player)
 	}
-	return results, nil
+	if err = rows.Err(); err != nil {
+		return nil, nil, "", fmt.Errorf("unable to list players: %w", err)
+	}
+	next_token := board.nextToken(offset, len(results))
+	results = results[:min(len(results), int(board.PageSize))]
+	if board.UserID == "" {
+		return results, nil, next_token, nil
+	}
+	own_query, args := board.ownQuery(source, order, "user_id", "user_id")
+	var me api.PlayerStat
+	err = r.pg.QueryRow(ctx, own_query, args...).Scan(&me.UserId, &me.TotalScore, &me.BestScore, &me.AvgScore, &me.NumSessions, &me.Rank)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return results, nil, next_token, nil
+		}
+		return nil, nil, "", fmt.Errorf("unable to get own rank: %w", err)
+	}
+	return results, &me, next_token, nil
 }
 
 func (r *Repository) GetAuthorStat(ctx context.Context, user_id string) (*api.AuthorStat, error) {
@@ -343,7 +724,8 @@ func (r *Repository) GetAuthorStat(ctx context.Context, user_id string) (*api.Au
 	}, nil
 }
 
-func (r *Repository) ListAuthors(ctx context.Context, option api.ListAuthorsOption) ([]*api.AuthorStat, error) {
+// ListAuthors возвращает страницу таблицы авторов и место пользователя board.UserID
+func (r *Repository) ListAuthors(ctx context.Context, option api.ListAuthorsOption, board Leaderboard) ([]*api.AuthorStat, *api.AuthorStat, string, error) {
 	var order string
 	switch option {
 	case api.ListAuthorsOption_NUM_QUIZZES:
@@ -353,41 +735,300 @@ func (r *Repository) ListAuthors(ctx context.Context, option api.ListAuthorsOpti
 	case api.ListAuthorsOption_BEST_QUIZ_RATE:
 		order = "best_quiz_rate"
 	default:
-		return nil, fmt.Errorf("no such option: %d", option)
+		return nil, nil, "", fmt.Errorf("no such option: %d", option)
 	}
-	list_query := fmt.Sprintf(`
-	SELECT 
+	source := `
+	SELECT
 		stats.authors.user_id,
 		stats.authors.num_quizzes,
-    	stats.authors.avg_quiz_rate,
+		stats.authors.avg_quiz_rate,
 		stats.authors.best_quiz_rate
 	FROM stats.authors
-	ORDER BY stats.authors.%s;
-	`, order)
-	rows, err := r.pg.Query(ctx, list_query)
+	`
+	if board.Since != nil {
+		// За период автор считается по своим квизам, которые играли или оценивали,
+		// оценка квиза - по оценкам за период
+		source = `
+	SELECT
+		quizzes.author_id AS user_id,
+		COUNT(*)::int AS num_quizzes,
+		COALESCE(AVG(quizzes.avg_rate) FILTER (WHERE quizzes.num_ratings > 0), 0) AS avg_quiz_rate,
+		COALESCE(MAX(quizzes.avg_rate) FILTER (WHERE quizzes.num_ratings > 0), 0) AS best_quiz_rate
+	FROM (` + quizPeriodQuery + `) AS quizzes
+	GROUP BY quizzes.author_id
+	`
+	}
+	list_query, args, offset, err := board.pageQuery(source, order, "user_id")
+	if err != nil {
+		return nil, nil, "", err
+	}
+	rows, err := r.pg.Query(ctx, list_query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("unable to list authors: %w", err)
+		return nil, nil, "", fmt.Errorf("unable to list authors: %w", err)
 	}
 	defer rows.Close()
 	var results []*api.AuthorStat
+	for rows.Next() {
+		var author api.AuthorStat
+		err = rows.Scan(&author.UserId, &author.NumQuizzes, &author.AvgQuizRate, &author.BestQuizRate, &author.Rank)
+		if err != nil {
+			return nil, nil, "", fmt.Errorf("scan failed: %w", err)
+		}
+		results = append(results, &author)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, nil, "", fmt.Errorf("unable to list authors: %w", err)
+	}
+	next_token := board.nextToken(offset, len(results))
+	results = results[:min(len(results), int(board.PageSize))]
+	if board.UserID == "" {
+		return results, nil, next_token, nil
+	}
+	own_query, args := board.ownQuery(source, order, "user_id", "user_id")
+	var me api.AuthorStat
+	err = r.pg.QueryRow(ctx, own_query, args...).Scan(&me.UserId, &me.NumQuizzes, &me.AvgQuizRate, &me.BestQuizRate, &me.Rank)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return results, nil, next_token, nil
+		}
+		return nil, nil, "", fmt.Errorf("unable to get own rank: %w", err)
+	}
+	return results, &me, next_token, nil
+}
+
+func teamScoring(scoring api.TeamScoring) string {
+	if scoring == api.TeamScoring_TEAM_SCORING_SUM {
+		return "sum"
+	}
+	return "average"
+}
+
+// RecordTeamResults сохраняет места команд в одной игре и пересчитывает их статистику.
+// Результат команды вставляется один раз, поэтому повторная отправка той же игры
+// не меняет счетчики, а прерванную отправку можно просто повторить.
+func (r *Repository) RecordTeamResults(
+	ctx context.Context,
+	source string,
+	source_id string,
+	quiz_id string,
+	owner string,
+	scoring api.TeamScoring,
+	teams []*api.TeamResult,
+) error {
+	team_upd_query := `
+	WITH inserted AS (
+		INSERT INTO stats.team_results (source, source_id, quiz_id, owner, team_name, score, rank, num_teams, num_players, scoring)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (source, source_id, owner, team_name) DO NOTHING
+		RETURNING owner, team_name, score, rank
+	)
+	INSERT INTO stats.teams (owner, team_name, num_sessions, wins, avg_rank, best_score, avg_score, updated_at)
+	SELECT owner, team_name, 1, CASE WHEN rank = 1 THEN 1 ELSE 0 END, rank, score, score, CURRENT_TIMESTAMP
+	FROM inserted
+	ON CONFLICT (owner, team_name) DO UPDATE
+	SET
+		wins = stats.teams.wins + EXCLUDED.wins,
+		avg_rank = (stats.teams.avg_rank * stats.teams.num_sessions + EXCLUDED.avg_rank) / (stats.teams.num_sessions + 1),
+		avg_score = (stats.teams.avg_score * stats.teams.num_sessions + EXCLUDED.avg_score) / (stats.teams.num_sessions + 1),
+		best_score = GREATEST(stats.teams.best_score, EXCLUDED.best_score),
+		num_sessions = stats.teams.num_sessions + 1,
+		updated_at = CURRENT_TIMESTAMP;
+	`
+	if (len(source) == 0) || (len(source_id) == 0) || (len(owner) == 0) || (len(teams) == 0) {
+		return fmt.Errorf("wrong request format")
+	}
+	for _, team := range teams {
+		if (len(team.GetTeam()) == 0) || (team.GetRank() < 1) {
+			return fmt.Errorf("wrong request format")
+		}
+	}
+	// Команды одной игры записываются в одной транзакции, иначе сбой посередине оставил бы половину игры
+	tx, err := r.pg.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	for _, team := range teams {
+		_, err = tx.Exec(ctx, team_upd_query, source, source_id, quiz_id, owner, team.GetTeam(),
+			team.GetScore(), team.GetRank(), int32(len(teams)), team.GetNumPlayers(), teamScoring(scoring))
+		if err != nil {
+			return fmt.Errorf("unable to update team statistics: %w", err)
+		}
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("unable to commit team statistics: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) GetTeamStat(ctx context.Context, owner string, team string) (*api.TeamStat, []*api.TeamHistoryEntry, error) {
+	team_stat_query := `
+	SELECT 
+		stats.teams.num_sessions,
+		stats.teams.wins,
+		stats.teams.avg_rank,
+		stats.teams.best_score,
+		stats.teams.avg_score
+	FROM stats.teams
+	WHERE stats.teams.owner = $1 AND stats.teams.team_name = $2;
+	`
+	history_query := `
+	SELECT 
+		stats.team_results.source,
+		stats.team_results.source_id,
+		stats.team_results.quiz_id,
+		stats.team_results.score,
+		stats.team_results.rank,
+		stats.team_results.num_teams,
+		stats.team_results.num_players,
+		stats.team_results.scoring,
+		stats.team_results.played_at
+	FROM stats.team_results
+	WHERE stats.team_results.owner = $1 AND stats.team_results.team_name = $2
+	ORDER BY stats.team_results.played_at DESC
+	LIMIT $3;
+	`
+	stat := &api.TeamStat{Owner: owner, Team: team}
+	err := r.pg.QueryRow(ctx, team_stat_query, owner, team).Scan(&stat.NumSessions, &stat.Wins, &stat.AvgRank, &stat.BestScore, &stat.AvgScore)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return stat, nil, nil
+		}
+		return nil, nil, fmt.Errorf("unable to get team statistics: %w", err)
+	}
+
+	rows, err := r.pg.Query(ctx, history_query, owner, team, teamHistoryLimit)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get team history: %w", err)
+	}
+	defer rows.Close()
+	var history []*api.TeamHistoryEntry
 	for rows.Next() {
 		var (
-			user_id        string
-			num_quizzes    int32
-			avg_quiz_rate  float32
-			best_quiz_rate float32
+			entry     api.TeamHistoryEntry
+			scoring   string
+			played_at time.Time
 		)
-		err = rows.Scan(&user_id, &num_quizzes, &avg_quiz_rate, &best_quiz_rate)
+		err = rows.Scan(&entry.Source, &entry.SourceId, &entry.QuizId, &entry.Score, &entry.Rank,
+			&entry.NumTeams, &entry.NumPlayers, &scoring, &played_at)
+		if err != nil {
+			return nil, nil, fmt.Errorf("scan failed: %w", err)
+		}
+		if scoring == "sum" {
+			entry.Scoring = api.TeamScoring_TEAM_SCORING_SUM
+		}
+		entry.PlayedAt = played_at.UTC().Format(time.RFC3339Nano)
+		history = append(history, &entry)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("unable to get team history: %w", err)
+	}
+	return stat, history, nil
+}
+
+func (r *Repository) ListTeams(ctx context.Context, option api.ListTeamsOption) ([]*api.TeamStat, error) {
+	var order string
+	switch option {
+	case api.ListTeamsOption_TEAM_WINS:
+		order = "wins DESC"
+	case api.ListTeamsOption_TEAM_AVG_RANK:
+		order = "avg_rank ASC"
+	case api.ListTeamsOption_TEAM_NUM_SESSIONS:
+		order = "num_sessions DESC"
+	default:
+		return nil, fmt.Errorf("no such option: %d", option)
+	}
+	// Лучшие команды идут первыми, при равенстве выше та, что сыграла больше
+	list_query := fmt.Sprintf(`
+	SELECT 
+		stats.teams.owner,
+		stats.teams.team_name,
+		stats.teams.num_sessions,
+		stats.teams.wins,
+		stats.teams.avg_rank,
+		stats.teams.best_score,
+		stats.teams.avg_score
+	FROM stats.teams
+	ORDER BY stats.teams.%s, stats.teams.num_sessions DESC
+	LIMIT $1;
+	`, order)
+	rows, err := r.pg.Query(ctx, list_query, teamsLimit)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list teams: %w", err)
+	}
+	defer rows.Close()
+	var results []*api.TeamStat
+	for rows.Next() {
+		var stat api.TeamStat
+		err = rows.Scan(&stat.Owner, &stat.Team, &stat.NumSessions, &stat.Wins, &stat.AvgRank, &stat.BestScore, &stat.AvgScore)
 		if err != nil {
 			return nil, fmt.Errorf("scan failed: %w", err)
 		}
-		results = append(results,
-			&api.AuthorStat{
-				UserId:       user_id,
-				NumQuizzes:   num_quizzes,
-				AvgQuizRate:  avg_quiz_rate,
-				BestQuizRate: best_quiz_rate,
-			})
+		results = append(results, &stat)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("unable to list teams: %w", err)
 	}
 	return results, nil
 }
+
+// UpdateDailyStreak отмечает, что игрок сыграл квиз дня day. Повторная отметка того же
+// или более раннего дня ничего не меняет, пропущенный день начинает серию заново.
+func (r *Repository) UpdateDailyStreak(ctx context.Context, player_id string, day time.Time) error {
+	streak_upd_query := `
+	INSERT INTO stats.daily_streaks (user_id, current_streak, best_streak, days_played, last_date)
+	VALUES ($1, 1, 1, 1, $2)
+	ON CONFLICT (user_id) DO UPDATE
+	SET
+		current_streak = CASE
+			WHEN stats.daily_streaks.last_date >= $2::date THEN stats.daily_streaks.current_streak
+			WHEN stats.daily_streaks.last_date = $2::date - 1 THEN stats.daily_streaks.current_streak + 1
+			ELSE 1
+		END,
+		best_streak = GREATEST(stats.daily_streaks.best_streak, CASE
+			WHEN stats.daily_streaks.last_date >= $2::date THEN stats.daily_streaks.current_streak
+			WHEN stats.daily_streaks.last_date = $2::date - 1 THEN stats.daily_streaks.current_streak + 1
+			ELSE 1
+		END),
+		days_played = stats.daily_streaks.days_played + CASE WHEN stats.daily_streaks.last_date >= $2::date THEN 0 ELSE 1 END,
+		last_date = GREATEST(stats.daily_streaks.last_date, $2::date);
+	`
+	if player_id == "" || day.IsZero() {
+		return fmt.Errorf("wrong request format")
+	}
+	_, err := r.pg.Exec(ctx, streak_upd_query, player_id, day)
+	if err != nil {
+		return fmt.Errorf("unable to update daily streak: %w", err)
+	}
+	return nil
+}
+
+// GetDailyStreak возвращает серию игрока на день today, серия без вчерашней игры уже прервана
+func (r *Repository) GetDailyStreak(ctx context.Context, user_id string, today time.Time) (*api.DailyStreak, error) {
+	streak_query := `
+	SELECT
+		stats.daily_streaks.current_streak,
+		stats.daily_streaks.best_streak,
+		stats.daily_streaks.days_played,
+		stats.daily_streaks.last_date
+	FROM stats.daily_streaks
+	WHERE stats.daily_streaks.user_id = $1;
+	`
+	streak := &api.DailyStreak{UserId: user_id}
+	var last_date time.Time
+	err := r.pg.QueryRow(ctx, streak_query, user_id).Scan(&streak.CurrentStreak, &streak.BestStreak, &streak.DaysPlayed, &last_date)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return streak, nil
+		}
+		return nil, fmt.Errorf("unable to get daily streak: %w", err)
+	}
+	last_played := last_date.Format(time.DateOnly)
+	streak.LastPlayed = last_played
+	streak.PlayedToday = last_played == today.Format(time.DateOnly)
+	if !streak.PlayedToday && last_played != today.AddDate(0, 0, -1).Format(time.DateOnly) {
+		streak.CurrentStreak = 0
+	}
+	return streak, nil
+}
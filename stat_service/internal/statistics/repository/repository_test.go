@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"quiz_app/internal/config"
 	api "quiz_app/pkg/api/v1"
 	"quiz_app/pkg/logger"
+	quizapi "quiz_app/pkg/quizapi/v1"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -34,11 +36,43 @@ func TestUpdateStats(t *testing.T) {
 	player_id := "player1"
 	player_score := float32(3.4)
 	rate := float32(4.5)
+	played_at := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	session := &api.PlayedSession{
+		SessionId: "session1",
+		QuizId:    quizID,
+		AuthorId:  authorID,
+		PlayerId:  player_id,
+		Score:     player_score,
+		QuizRate:  rate,
+		// Ровно две минуты от начала до конца сессии
+		DurationMs: 120000,
+		PlayedAt:   played_at.Format(time.RFC3339Nano),
+	}
 
 	t.Run("successful update", func(t *testing.T) {
-		// Ожидаем вызовы для каждого запроса
+		// Сессия записывается в историю, статистика пересчитывается по ней в одной транзакции
+		mock.ExpectBegin()
+		mock.ExpectExec("pg_advisory_xact_lock").
+			WithArgs(quizID, player_id).
+			WillReturnResult(pgxmock.NewResult("SELECT", 1))
+
+		mock.ExpectExec("INSERT INTO stats.sessions").
+			WithArgs("key1", "session1", quizID, authorID, player_id, player_score, rate, int64(120000), played_at).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		mock.ExpectExec("INSERT INTO stats.session_answers").
+			WithArgs("session1", quizID, "q1", true, int64(5000), []string{}).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mock.ExpectExec("INSERT INTO stats.session_answers").
+			WithArgs("session1", quizID, "q2", false, int64(9000), []string{"a3"}).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
 		mock.ExpectExec("INSERT INTO stats.quizzes").
-			WithArgs(rate, quizID, authorID).
+			WithArgs(quizID, authorID).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		mock.ExpectExec("WITH ratings AS").
+			WithArgs(quizID).
 			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
 
 		mock.ExpectExec("WITH author_stats AS").
@@ -46,23 +80,393 @@ func TestUpdateStats(t *testing.T) {
 			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
 
 		mock.ExpectExec("INSERT INTO stats.players").
-			WithArgs(player_score, player_id).
+			WithArgs(player_id).
 			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		mock.ExpectCommit()
 
-		err := repo.UpdateStats(ctx, quizID, authorID, player_id, player_score, rate)
+		answers := []*quizapi.SessionAnswerResult{
+			{QuestionId: "q1", IsCorrect: true, ElapsedMs: 5000},
+			{QuestionId: "q2", ElapsedMs: 9000, WrongAnswerIds: []string{"a3"}},
+		}
+		recorded, replayed, err := repo.UpdateStats(ctx, "key1", session, answers)
 		assert.NoError(t, err)
+		assert.False(t, replayed)
+		assert.Equal(t, session, recorded)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("repeated key", func(t *testing.T) {
+		// Повтор ничего не пересчитывает и возвращает записанную в первый раз сессию
+		mock.ExpectBegin()
+		mock.ExpectExec("pg_advisory_xact_lock").
+			WithArgs(quizID, player_id).
+			WillReturnResult(pgxmock.NewResult("SELECT", 1))
+
+		mock.ExpectExec("INSERT INTO stats.sessions").
+			WithArgs("key1", "session1", quizID, authorID, player_id, float32(5), rate, int64(120000), played_at).
+			WillReturnResult(pgxmock.NewResult("INSERT", 0))
+
+		mock.ExpectQuery("SELECT").
+			WithArgs("key1", "session1").
+			WillReturnRows(pgxmock.NewRows([]string{"session_id", "quiz_id", "author_id", "player_id", "score", "quiz_rate", "duration_ms", "played_at"}).
+				AddRow("session1", quizID, authorID, player_id, player_score, rate, int64(120000), played_at))
+		mock.ExpectRollback()
+
+		retry := &api.PlayedSession{
+			SessionId: "session1", QuizId: quizID, AuthorId: authorID, PlayerId: player_id,
+			Score: 5, QuizRate: rate, DurationMs: 120000, PlayedAt: session.PlayedAt,
+		}
+		recorded, replayed, err := repo.UpdateStats(ctx, "key1", retry, nil)
+		assert.NoError(t, err)
+		assert.True(t, replayed)
+		assert.Equal(t, session, recorded)
+		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
 	t.Run("wrong format update error", func(t *testing.T) {
+		_, _, err := repo.UpdateStats(ctx, "key1", &api.PlayedSession{SessionId: "session1", QuizId: "quiz1", AuthorId: "author1", PlayedAt: session.PlayedAt}, nil)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "wrong request format")
+
+		_, _, err = repo.UpdateStats(ctx, "key1", &api.PlayedSession{SessionId: "session1", QuizId: "quiz1", AuthorId: "author1", PlayerId: "player1", QuizRate: 6, PlayedAt: session.PlayedAt}, nil)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "wrong request format")
+
+		_, _, err = repo.UpdateStats(ctx, "", session, nil)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "wrong request format")
+
+		_, _, err = repo.UpdateStats(ctx, "key1", session, []*quizapi.SessionAnswerResult{{ElapsedMs: 1000}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "wrong request format")
+	})
+
+	t.Run("failure rolls back", func(t *testing.T) {
+		// Ошибка на последнем пересчете откатывает и сессию, и уже пересчитанную статистику
+		mock.ExpectBegin()
+		mock.ExpectExec("pg_advisory_xact_lock").
+			WithArgs(quizID, player_id).
+			WillReturnResult(pgxmock.NewResult("SELECT", 1))
+
+		mock.ExpectExec("INSERT INTO stats.sessions").
+			WithArgs("key2", "session1", quizID, authorID, player_id, player_score, rate, int64(120000), played_at).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
 		mock.ExpectExec("INSERT INTO stats.quizzes").
 			WithArgs(quizID, authorID).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		mock.ExpectExec("WITH ratings AS").
+			WithArgs(quizID).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		mock.ExpectExec("WITH author_stats AS").
+			WithArgs(authorID).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		mock.ExpectExec("INSERT INTO stats.players").
+			WithArgs(player_id).
 			WillReturnError(errors.New("update failed"))
+		mock.ExpectRollback()
+
+		_, _, err := repo.UpdateStats(ctx, "key2", session, nil)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unable to update player statistics")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 
-		err := repo.UpdateStats(ctx, "quiz1", "author1", "", 0, 0)
+	t.Run("history error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("pg_advisory_xact_lock").
+			WithArgs(quizID, player_id).
+			WillReturnResult(pgxmock.NewResult("SELECT", 1))
+
+		mock.ExpectExec("INSERT INTO stats.sessions").
+			WithArgs("key1", "session1", quizID, authorID, player_id, player_score, rate, int64(120000), played_at).
+			WillReturnError(errors.New("insert failed"))
+		mock.ExpectRollback()
+
+		_, _, err := repo.UpdateStats(ctx, "key1", session, nil)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unable to record session")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+}
+
+func TestListPlayerSessions(t *testing.T) {
+	ctx, _ := logger.New(context.Background())
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	repo := &Repository{pg: mock}
+	columns := []string{"id", "session_id", "quiz_id", "author_id", "player_id", "score", "quiz_rate", "duration_ms", "played_at"}
+	first := time.Date(2026, 5, 2, 10, 0, 0, 123456000, time.UTC)
+	second := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	third := time.Date(2026, 4, 30, 10, 0, 0, 0, time.UTC)
+
+	var next_token string
+	t.Run("first page", func(t *testing.T) {
+		var no_token *time.Time
+		mock.ExpectQuery("SELECT").
+			WithArgs("player1", no_token, int64(0), int32(3)).
+			WillReturnRows(pgxmock.NewRows(columns).
+				AddRow(int64(3), "s3", "quiz1", "author1", "player1", float32(900), float32(5), int64(60000), first).
+				AddRow(int64(2), "s2", "quiz2", "author1", "player1", float32(400), float32(3), int64(90000), second).
+				AddRow(int64(1), "s1", "quiz1", "author1", "player1", float32(700), float32(4), int64(75000), third))
+
+		sessions, token, err := repo.ListPlayerSessions(ctx, "player1", 2, "")
+		require.NoError(t, err)
+		assert.Equal(t, []*api.PlayedSession{
+			{SessionId: "s3", QuizId: "quiz1", AuthorId: "author1", PlayerId: "player1", Score: 900, QuizRate: 5, DurationMs: 60000, PlayedAt: "2026-05-02T10:00:00.123456Z"},
+			{SessionId: "s2", QuizId: "quiz2", AuthorId: "author1", PlayerId: "player1", Score: 400, QuizRate: 3, DurationMs: 90000, PlayedAt: "2026-05-01T10:00:00Z"},
+		}, sessions)
+		require.NotEmpty(t, token)
+		next_token = token
+	})
+
+	t.Run("last page", func(t *testing.T) {
+		after, after_id, err := decodeSessionsToken(next_token)
+		require.NoError(t, err)
+		assert.True(t, after.Equal(second))
+		assert.Equal(t, int64(2), after_id)
+
+		mock.ExpectQuery("SELECT").
+			WithArgs("player1", after, int64(2), int32(3)).
+			WillReturnRows(pgxmock.NewRows(columns).
+				AddRow(int64(1), "s1", "quiz1", "author1", "player1", float32(700), float32(4), int64(75000), third))
+
+		sessions, token, err := repo.ListPlayerSessions(ctx, "player1", 2, next_token)
+		require.NoError(t, err)
+		assert.Len(t, sessions, 1)
+		assert.Empty(t, token)
+	})
+
+	t.Run("bad token", func(t *testing.T) {
+		_, _, err := repo.ListQuizSessions(ctx, "quiz1", 2, "not a token")
+		assert.ErrorIs(t, err, ErrPageToken)
+	})
+}
+
+func TestRateQuiz(t *testing.T) {
+	ctx, _ := logger.New(context.Background())
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	repo := &Repository{pg: mock}
+	updated_at := time.Date(2026, 5, 3, 9, 0, 0, 0, time.UTC)
+
+	t.Run("successful rating", func(t *testing.T) {
+		// Оценка заменяет прежнюю, оценка квиза и статистика автора пересчитываются в той же транзакции
+		mock.ExpectBegin()
+		mock.ExpectExec("pg_advisory_xact_lock").
+			WithArgs("quiz1").
+			WillReturnResult(pgxmock.NewResult("SELECT", 1))
+		mock.ExpectExec("INSERT INTO stats.quizzes").
+			WithArgs("quiz1", "author1").
+			WillReturnResult(pgxmock.NewResult("INSERT", 0))
+
+		mock.ExpectQuery("SELECT").
+			WithArgs("quiz1").
+			WillReturnRows(pgxmock.NewRows([]string{"author_id"}).AddRow("author1"))
+
+		mock.ExpectQuery("INSERT INTO stats.ratings").
+			WithArgs("quiz1", "player1", float32(4), "Nice questions").
+			WillReturnRows(pgxmock.NewRows([]string{"updated_at"}).AddRow(updated_at))
+
+		mock.ExpectQuery("WITH ratings AS").
+			WithArgs("quiz1").
+			WillReturnRows(pgxmock.NewRows([]string{"author_id", "num_sessions", "avg_rate", "num_ratings"}).
+				AddRow("author1", int32(12), float32(4.25), int32(8)))
+
+		mock.ExpectExec("WITH author_stats AS").
+			WithArgs("author1").
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		mock.ExpectCommit()
+
+		rating, quiz, err := repo.RateQuiz(ctx, "quiz1", "author1", "player1", 4, "Nice questions")
+		require.NoError(t, err)
+		assert.Equal(t, &api.QuizRating{QuizId: "quiz1", UserId: "player1", Rate: 4, Review: "Nice questions", UpdatedAt: "2026-05-03T09:00:00Z"}, rating)
+		assert.Equal(t, &api.QuizStat{QuizId: "quiz1", AuthorId: "author1", NumSessions: 12, AvgRate: 4.25, NumRatings: 8}, quiz)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("own quiz", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("pg_advisory_xact_lock").
+			WithArgs("quiz1").
+			WillReturnResult(pgxmock.NewResult("SELECT", 1))
+		mock.ExpectExec("INSERT INTO stats.quizzes").
+			WithArgs("quiz1", "author1").
+			WillReturnResult(pgxmock.NewResult("INSERT", 0))
+		mock.ExpectQuery("SELECT").
+			WithArgs("quiz1").
+			WillReturnRows(pgxmock.NewRows([]string{"author_id"}).AddRow("author1"))
+		mock.ExpectRollback()
+
+		_, _, err := repo.RateQuiz(ctx, "quiz1", "author1", "author1", 5, "")
+		assert.ErrorIs(t, err, ErrOwnQuiz)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("first rating", func(t *testing.T) {
+		// Квиз, который еще никто не доиграл, заводится в статистике при первой оценке
+		mock.ExpectBegin()
+		mock.ExpectExec("pg_advisory_xact_lock").
+			WithArgs("quiz2").
+			WillReturnResult(pgxmock.NewResult("SELECT", 1))
+		mock.ExpectExec("INSERT INTO stats.quizzes").
+			WithArgs("quiz2", "author2").
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mock.ExpectQuery("SELECT").
+			WithArgs("quiz2").
+			WillReturnRows(pgxmock.NewRows([]string{"author_id"}).AddRow("author2"))
+		mock.ExpectQuery("INSERT INTO stats.ratings").
+			WithArgs("quiz2", "player1", float32(5), "").
+			WillReturnRows(pgxmock.NewRows([]string{"updated_at"}).AddRow(updated_at))
+		mock.ExpectQuery("WITH ratings AS").
+			WithArgs("quiz2").
+			WillReturnRows(pgxmock.NewRows([]string{"author_id", "num_sessions", "avg_rate", "num_ratings"}).
+				AddRow("author2", int32(0), float32(5), int32(1)))
+		mock.ExpectExec("WITH author_stats AS").
+			WithArgs("author2").
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		mock.ExpectCommit()
+
+		_, quiz, err := repo.RateQuiz(ctx, "quiz2", "author2", "player1", 5, "")
+		require.NoError(t, err)
+		assert.Equal(t, &api.QuizStat{QuizId: "quiz2", AuthorId: "author2", NumSessions: 0, AvgRate: 5, NumRatings: 1}, quiz)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("wrong format", func(t *testing.T) {
+		_, _, err := repo.RateQuiz(ctx, "quiz1", "author1", "player1", 0, "")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "wrong request format")
+
+		_, _, err = repo.RateQuiz(ctx, "", "author1", "player1", 3, "")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "wrong request format")
 	})
+}
+
+func TestListQuizRatings(t *testing.T) {
+	ctx, _ := logger.New(context.Background())
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	repo := &Repository{pg: mock}
+	columns := []string{"user_id", "rate", "review", "updated_at"}
+	first := time.Date(2026, 5, 2, 10, 0, 0, 123456000, time.UTC)
+	second := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	var no_token *time.Time
+	mock.ExpectQuery("SELECT").
+		WithArgs("quiz1", no_token, "", int32(2)).
+		WillReturnRows(pgxmock.NewRows(columns).
+			AddRow("player2", float32(5), "Great", first).
+			AddRow("player1", float32(3), "", second))
+
+	ratings, token, err := repo.ListQuizRatings(ctx, "quiz1", 1, "")
+	require.NoError(t, err)
+	assert.Equal(t, []*api.QuizRating{
+		{QuizId: "quiz1", UserId: "player2", Rate: 5, Review: "Great", UpdatedAt: "2026-05-02T10:00:00.123456Z"},
+	}, ratings)
+
+	after, after_user, err := decodeRatingsToken(token)
+	require.NoError(t, err)
+	assert.True(t, after.Equal(first))
+	assert.Equal(t, "player2", after_user)
 
+	_, _, err = repo.ListQuizRatings(ctx, "quiz1", 1, "bad:token")
+	assert.ErrorIs(t, err, ErrPageToken)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetQuestionStats(t *testing.T) {
+	ctx, _ := logger.New(context.Background())
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	repo := &Repository{pg: mock}
+	columns := []string{"question_id", "session_id", "score", "is_correct", "elapsed_ms", "wrong_answer_ids"}
+
+	t.Run("successful analytics", func(t *testing.T) {
+		// Ответы на вопрос идут от лучшей сессии к худшей
+		mock.ExpectQuery("SELECT").
+			WithArgs("quiz1").
+			WillReturnRows(pgxmock.NewRows(columns).
+				AddRow("q1", "s1", float32(900), true, int64(4000), []string{}).
+				AddRow("q1", "s2", float32(700), true, int64(6000), []string{}).
+				AddRow("q1", "s3", float32(500), false, int64(8000), []string{"a2"}).
+				AddRow("q1", "s4", float32(100), false, int64(10000), []string{"a3"}).
+				AddRow("q2", "s1", float32(900), false, int64(3000), []string{"b2"}).
+				AddRow("q2", "s4", float32(100), false, int64(5000), []string{"b2", "b3"}))
+
+		num_sessions, questions, err := repo.GetQuestionStats(ctx, "quiz1")
+		require.NoError(t, err)
+		assert.Equal(t, int32(4), num_sessions)
+		assert.Equal(t, []*api.QuestionStat{
+			{
+				QuestionId: "q1", NumAnswers: 4, PercentCorrect: 50, AvgTimeMs: 7000,
+				Distractors: []*api.DistractorStat{
+					{AnswerId: "a2", NumPicked: 1, PercentPicked: 25},
+					{AnswerId: "a3", NumPicked: 1, PercentPicked: 25},
+				},
+				DiscriminationIndex: 1,
+			},
+			{
+				QuestionId: "q2", NumAnswers: 2, PercentCorrect: 0, AvgTimeMs: 4000,
+				Distractors: []*api.DistractorStat{
+					{AnswerId: "b2", NumPicked: 2, PercentPicked: 100},
+					{AnswerId: "b3", NumPicked: 1, PercentPicked: 50},
+				},
+				DiscriminationIndex: 0,
+			},
+		}, questions)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ambiguous question", func(t *testing.T) {
+		// Слабые игроки отвечают верно чаще сильных
+		mock.ExpectQuery("SELECT").
+			WithArgs("quiz2").
+			WillReturnRows(pgxmock.NewRows(columns).
+				AddRow("q1", "s1", float32(900), false, int64(1000), []string{"a2"}).
+				AddRow("q1", "s2", float32(100), true, int64(1000), []string{}))
+
+		_, questions, err := repo.GetQuestionStats(ctx, "quiz2")
+		require.NoError(t, err)
+		require.Len(t, questions, 1)
+		assert.Equal(t, float32(-1), questions[0].DiscriminationIndex)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("no answers", func(t *testing.T) {
+		mock.ExpectQuery("SELECT").
+			WithArgs("quiz3").
+			WillReturnRows(pgxmock.NewRows(columns))
+
+		num_sessions, questions, err := repo.GetQuestionStats(ctx, "quiz3")
+		require.NoError(t, err)
+		assert.Zero(t, num_sessions)
+		assert.Empty(t, questions)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		mock.ExpectQuery("SELECT").
+			WithArgs("quiz1").
+			WillReturnError(errors.New("query failed"))
+
+		_, _, err := repo.GetQuestionStats(ctx, "quiz1")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unable to get answers")
+	})
 }
 
 func TestGetQuizStat(t *testing.T) {
@@ -80,12 +484,13 @@ func TestGetQuizStat(t *testing.T) {
 			AuthorId:    "author1",
 			NumSessions: 10,
 			AvgRate:     4.5,
+			NumRatings:  4,
 		}
 
 		mock.ExpectQuery("SELECT").
 			WithArgs(quizID).
-			WillReturnRows(pgxmock.NewRows([]string{"author_id", "num_sessions", "avg_rate"}).
-				AddRow(expected.AuthorId, expected.NumSessions, expected.AvgRate))
+			WillReturnRows(pgxmock.NewRows([]string{"author_id", "num_sessions", "avg_rate", "num_ratings"}).
+				AddRow(expected.AuthorId, expected.NumSessions, expected.AvgRate, expected.NumRatings))
 
 		result, err := repo.GetQuizStat(ctx, quizID)
 		require.NoError(t, err)
@@ -109,63 +514,65 @@ func TestListQuizzes(t *testing.T) {
 	defer mock.Close()
 
 	repo := &Repository{pg: mock}
+	columns := []string{"quiz_id", "author_id", "num_sessions", "avg_rate", "num_ratings", "rank"}
 
 	t.Run("by AvgRate", func(t *testing.T) {
 		expected := []*api.QuizStat{
-			{
-				QuizId:      "quiz1",
-				AuthorId:    "author1",
-				NumSessions: 5,
-				AvgRate:     4.5,
-			},
-			{
-				QuizId:      "quiz2",
-				AuthorId:    "author2",
-				NumSessions: 10,
-				AvgRate:     4.0,
-			},
+			{QuizId: "quiz1", AuthorId: "author1", NumSessions: 5, AvgRate: 4.5, NumRatings: 2, Rank: 1},
+			{QuizId: "quiz2", AuthorId: "author2", NumSessions: 10, AvgRate: 4.0, NumRatings: 7, Rank: 2},
 		}
 
-		mock.ExpectQuery("SELECT").
-			WillReturnRows(pgxmock.NewRows([]string{"quiz_id", "author_id", "num_sessions", "avg_rate"}).
-				AddRow(expected[0].QuizId, expected[0].AuthorId, expected[0].NumSessions, expected[0].AvgRate).
-				AddRow(expected[1].QuizId, expected[1].AuthorId, expected[1].NumSessions, expected[1].AvgRate))
+		// Лучшие квизы идут первыми
+		mock.ExpectQuery("ORDER BY board.avg_rate DESC").
+			WithArgs(int32(21), int32(0)).
+			WillReturnRows(pgxmock.NewRows(columns).
+				AddRow("quiz1", "author1", int32(5), float32(4.5), int32(2), int32(1)).
+				AddRow("quiz2", "author2", int32(10), float32(4.0), int32(7), int32(2)))
 
-		result, err := repo.ListQuizzes(ctx, api.ListQuizzesOption_AVG_RATE)
+		result, mine, token, err := repo.ListQuizzes(ctx, api.ListQuizzesOption_AVG_RATE, Leaderboard{PageSize: 20})
 		require.NoError(t, err)
 		assert.Equal(t, expected, result)
+		assert.Empty(t, mine)
+		assert.Empty(t, token)
+		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
-	t.Run("by NumSessions", func(t *testing.T) {
-		expected := []*api.QuizStat{
-			{
-				QuizId:      "quiz1",
-				AuthorId:    "author1",
-				NumSessions: 5,
-				AvgRate:     4.5,
-			},
-			{
-				QuizId:      "quiz2",
-				AuthorId:    "author2",
-				NumSessions: 10,
-				AvgRate:     4.0,
-			},
-		}
+	t.Run("by NumSessions for a week with own quizzes", func(t *testing.T) {
+		since := time.Date(2026, 4, 27, 0, 0, 0, 0, time.UTC)
+		board := Leaderboard{Since: &since, Ascending: true, PageSize: 1, UserID: "author2"}
 
-		mock.ExpectQuery("SELECT").
-			WillReturnRows(pgxmock.NewRows([]string{"quiz_id", "author_id", "num_sessions", "avg_rate"}).
-				AddRow(expected[0].QuizId, expected[0].AuthorId, expected[0].NumSessions, expected[0].AvgRate).
-				AddRow(expected[1].QuizId, expected[1].AuthorId, expected[1].NumSessions, expected[1].AvgRate))
+		mock.ExpectQuery("ORDER BY board.num_sessions ASC").
+			WithArgs(since, int32(2), int32(0)).
+			WillReturnRows(pgxmock.NewRows(columns).
+				AddRow("quiz1", "author1", int32(1), float32(0), int32(0), int32(1)).
+				AddRow("quiz3", "author1", int32(2), float32(5), int32(1), int32(2)))
 
-		result, err := repo.ListQuizzes(ctx, api.ListQuizzesOption_NUM_SESSIONS)
+		// Места квизов автора считаются по всей таблице, а не по странице
+		mock.ExpectQuery("WHERE ranked.author_id = \\$2").
+			WithArgs(since, "author2").
+			WillReturnRows(pgxmock.NewRows(columns).
+				AddRow("quiz2", "author2", int32(7), float32(4), int32(3), int32(5)))
+
+		result, mine, token, err := repo.ListQuizzes(ctx, api.ListQuizzesOption_NUM_SESSIONS, board)
 		require.NoError(t, err)
-		assert.Equal(t, expected, result)
+		assert.Equal(t, []*api.QuizStat{{QuizId: "quiz1", AuthorId: "author1", NumSessions: 1, Rank: 1}}, result)
+		assert.Equal(t, []*api.QuizStat{{QuizId: "quiz2", AuthorId: "author2", NumSessions: 7, AvgRate: 4, NumRatings: 3, Rank: 5}}, mine)
+
+		offset, err := decodeOffsetToken(token)
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), offset)
+		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
 	t.Run("invalid option", func(t *testing.T) {
-		_, err := repo.ListQuizzes(ctx, 999)
+		_, _, _, err := repo.ListQuizzes(ctx, 999, Leaderboard{PageSize: 20})
 		assert.Error(t, err)
 	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		_, _, _, err := repo.ListQuizzes(ctx, api.ListQuizzesOption_AVG_RATE, Leaderboard{PageSize: 20, PageToken: "not a token"})
+		assert.ErrorIs(t, err, ErrPageToken)
+	})
 }
 
 func TestGetPlayerStat(t *testing.T) {
@@ -223,93 +630,75 @@ func TestListPlayers(t *testing.T) {
 	defer mock.Close()
 
 	repo := &Repository{pg: mock}
-
-	t.Run("by TotalScore", func(t *testing.T) {
-		expected := []*api.PlayerStat{
-			{
-				UserId:      "player1",
-				TotalScore:  100,
-				BestScore:   100,
-				AvgScore:    100,
-				NumSessions: 1,
-			},
-			{
-				UserId:      "player2",
-				TotalScore:  200,
-				BestScore:   200,
-				AvgScore:    200,
-				NumSessions: 1,
-			},
-		}
-
-		mock.ExpectQuery("SELECT").
-			WillReturnRows(pgxmock.NewRows([]string{"user_id", "total_score", "best_score", "avg_score", "num_sessions"}).
-				AddRow(expected[0].UserId, expected[0].TotalScore, expected[0].BestScore, expected[0].AvgScore, expected[0].NumSessions).
-				AddRow(expected[1].UserId, expected[1].TotalScore, expected[1].BestScore, expected[1].AvgScore, expected[1].NumSessions))
-
-		result, err := repo.ListPlayers(ctx, api.ListPlayersOption_TOTAL_SCORE)
+	columns := []string{"user_id", "total_score", "best_score", "avg_score", "num_sessions", "rank"}
+
+	for option, order := range map[api.ListPlayersOption]string{
+		api.ListPlayersOption_TOTAL_SCORE: "total_score",
+		api.ListPlayersOption_BEST_SCORE:  "best_score",
+		api.ListPlayersOption_AVG_SCORE:   "avg_score",
+	} {
+		t.Run("by "+order, func(t *testing.T) {
+			expected := []*api.PlayerStat{
+				{UserId: "player2", TotalScore: 200, BestScore: 200, AvgScore: 200, NumSessions: 1, Rank: 1},
+				{UserId: "player1", TotalScore: 100, BestScore: 100, AvgScore: 100, NumSessions: 1, Rank: 2},
+			}
+
+			mock.ExpectQuery("ORDER BY board."+order+" DESC").
+				WithArgs(int32(21), int32(0)).
+				WillReturnRows(pgxmock.NewRows(columns).
+					AddRow("player2", float32(200), float32(200), float32(200), int32(1), int32(1)).
+					AddRow("player1", float32(100), float32(100), float32(100), int32(1), int32(2)))
+
+			result, me, token, err := repo.ListPlayers(ctx, option, Leaderboard{PageSize: 20})
+			require.NoError(t, err)
+			assert.Equal(t, expected, result)
+			assert.Nil(t, me)
+			assert.Empty(t, token)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+
+	t.Run("second page of the day with own rank", func(t *testing.T) {
+		since := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+		board := Leaderboard{Since: &since, PageSize: 2, PageToken: encodeOffsetToken(2), UserID: "player9"}
+
+		mock.ExpectQuery("FROM stats.sessions").
+			WithArgs(since, int32(3), int32(2)).
+			WillReturnRows(pgxmock.NewRows(columns).
+				AddRow("player3", float32(300), float32(300), float32(300), int32(1), int32(3)).
+				AddRow("player4", float32(300), float32(300), float32(300), int32(1), int32(3)))
+
+		mock.ExpectQuery("WHERE ranked.user_id = \\$2").
+			WithArgs(since, "player9").
+			WillReturnRows(pgxmock.NewRows(columns).
+				AddRow("player9", float32(50), float32(50), float32(50), int32(1), int32(40)))
+
+		result, me, token, err := repo.ListPlayers(ctx, api.ListPlayersOption_TOTAL_SCORE, board)
 		require.NoError(t, err)
-		assert.Equal(t, expected, result)
+		assert.Len(t, result, 2)
+		assert.Equal(t, int32(3), result[1].Rank)
+		assert.Equal(t, &api.PlayerStat{UserId: "player9", TotalScore: 50, BestScore: 50, AvgScore: 50, NumSessions: 1, Rank: 40}, me)
+		assert.Empty(t, token)
+		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
-	t.Run("by BestScore", func(t *testing.T) {
-		expected := []*api.PlayerStat{
-			{
-				UserId:      "player1",
-				TotalScore:  100,
-				BestScore:   100,
-				AvgScore:    100,
-				NumSessions: 1,
-			},
-			{
-				UserId:      "player2",
-				TotalScore:  200,
-				BestScore:   200,
-				AvgScore:    200,
-				NumSessions: 2,
-			},
-		}
-
-		mock.ExpectQuery("SELECT").
-			WillReturnRows(pgxmock.NewRows([]string{"user_id", "total_score", "best_score", "avg_score", "num_sessions"}).
-				AddRow(expected[0].UserId, expected[0].TotalScore, expected[0].BestScore, expected[0].AvgScore, expected[0].NumSessions).
-				AddRow(expected[1].UserId, expected[1].TotalScore, expected[1].BestScore, expected[1].AvgScore, expected[1].NumSessions))
-
-		result, err := repo.ListPlayers(ctx, api.ListPlayersOption_BEST_SCORE)
-		require.NoError(t, err)
-		assert.Equal(t, expected, result)
-	})
-
-	t.Run("by AvgScore", func(t *testing.T) {
-		expected := []*api.PlayerStat{
-			{
-				UserId:      "player1",
-				TotalScore:  100,
-				BestScore:   100,
-				AvgScore:    100,
-				NumSessions: 1,
-			},
-			{
-				UserId:      "player2",
-				TotalScore:  200,
-				BestScore:   200,
-				AvgScore:    200,
-				NumSessions: 2,
-			},
-		}
-
-		mock.ExpectQuery("SELECT").
-			WillReturnRows(pgxmock.NewRows([]string{"user_id", "total_score", "best_score", "avg_score", "num_sessions"}).
-				AddRow(expected[0].UserId, expected[0].TotalScore, expected[0].BestScore, expected[0].AvgScore, expected[0].NumSessions).
-				AddRow(expected[1].UserId, expected[1].TotalScore, expected[1].BestScore, expected[1].AvgScore, expected[1].NumSessions))
+	t.Run("caller did not play", func(t *testing.T) {
+		mock.ExpectQuery("ORDER BY board.total_score DESC").
+			WithArgs(int32(21), int32(0)).
+			WillReturnRows(pgxmock.NewRows(columns))
+		mock.ExpectQuery("WHERE ranked.user_id = \\$1").
+			WithArgs("player9").
+			WillReturnError(pgx.ErrNoRows)
 
-		result, err := repo.ListPlayers(ctx, api.ListPlayersOption_AVG_SCORE)
+		result, me, _, err := repo.ListPlayers(ctx, api.ListPlayersOption_TOTAL_SCORE, Leaderboard{PageSize: 20, UserID: "player9"})
 		require.NoError(t, err)
-		assert.Equal(t, expected, result)
+		assert.Empty(t, result)
+		assert.Nil(t, me)
+		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
 	t.Run("invalid option", func(t *testing.T) {
-		_, err := repo.ListPlayers(ctx, 999)
+		_, _, _, err := repo.ListPlayers(ctx, 999, Leaderboard{PageSize: 20})
 		assert.Error(t, err)
 	})
 }
@@ -367,61 +756,240 @@ func TestListAuthors(t *testing.T) {
 	defer mock.Close()
 
 	repo := &Repository{pg: mock}
+	columns := []string{"user_id", "num_quizzes", "avg_quiz_rate", "best_quiz_rate", "rank"}
+
+	for option, order := range map[api.ListAuthorsOption]string{
+		api.ListAuthorsOption_NUM_QUIZZES:    "num_quizzes",
+		api.ListAuthorsOption_AVG_QUIZ_RATE:  "avg_quiz_rate",
+		api.ListAuthorsOption_BEST_QUIZ_RATE: "best_quiz_rate",
+	} {
+		t.Run("by "+order, func(t *testing.T) {
+			expected := []*api.AuthorStat{
+				{UserId: "author2", NumQuizzes: 10, AvgQuizRate: 4.5, BestQuizRate: 4.9, Rank: 1},
+				{UserId: "author1", NumQuizzes: 5, AvgQuizRate: 3.5, BestQuizRate: 3.9, Rank: 2},
+			}
+
+			mock.ExpectQuery("ORDER BY board."+order+" DESC").
+				WithArgs(int32(21), int32(0)).
+				WillReturnRows(pgxmock.NewRows(columns).
+					AddRow("author2", int32(10), float32(4.5), float32(4.9), int32(1)).
+					AddRow("author1", int32(5), float32(3.5), float32(3.9), int32(2)))
+
+			result, me, token, err := repo.ListAuthors(ctx, option, Leaderboard{PageSize: 20})
+			require.NoError(t, err)
+			assert.Equal(t, expected, result)
+			assert.Nil(t, me)
+			assert.Empty(t, token)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+
+	t.Run("month with own rank", func(t *testing.T) {
+		since := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+		board := Leaderboard{Since: &since, PageSize: 20, UserID: "author1"}
+
+		mock.ExpectQuery("FROM stats.ratings").
+			WithArgs(since, int32(21), int32(0)).
+			WillReturnRows(pgxmock.NewRows(columns).
+				AddRow("author1", int32(2), float32(4), float32(4.5), int32(1)))
+		mock.ExpectQuery("WHERE ranked.user_id = \\$2").
+			WithArgs(since, "author1").
+			WillReturnRows(pgxmock.NewRows(columns).
+				AddRow("author1", int32(2), float32(4), float32(4.5), int32(1)))
+
+		result, me, _, err := repo.ListAuthors(ctx, api.ListAuthorsOption_AVG_QUIZ_RATE, board)
+		require.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, result[0], me)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 
-	t.Run("by AvgQuizRate", func(t *testing.T) {
-		expected := []*api.AuthorStat{
-			{
-				UserId:       "author1",
-				NumQuizzes:   5,
-				AvgQuizRate:  float32(3.5),
-				BestQuizRate: float32(3.9),
-			},
-			{
-				UserId:       "author2",
-				NumQuizzes:   10,
-				AvgQuizRate:  float32(4.5),
-				BestQuizRate: float32(4.9),
-			},
-		}
+	t.Run("invalid option", func(t *testing.T) {
+		_, _, _, err := repo.ListAuthors(ctx, 999, Leaderboard{PageSize: 20})
+		assert.Error(t, err)
+	})
+}
+
+func TestPeriodStart(t *testing.T) {
+	// Пятница, 1 мая 2026 года, 01:30 по Москве - еще 30 апреля по UTC
+	now := time.Date(2026, 5, 1, 1, 30, 0, 0, time.FixedZone("MSK", 3*60*60))
+	for period, expected := range map[api.LeaderboardPeriod]time.Time{
+		api.LeaderboardPeriod_PERIOD_DAILY:   time.Date(2026, 4, 30, 0, 0, 0, 0, time.UTC),
+		api.LeaderboardPeriod_PERIOD_WEEKLY:  time.Date(2026, 4, 27, 0, 0, 0, 0, time.UTC),
+		api.LeaderboardPeriod_PERIOD_MONTHLY: time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC),
+	} {
+		since, err := PeriodStart(period, now)
+		require.NoError(t, err)
+		assert.True(t, since.Equal(expected), "%s: got %v", period, since)
+	}
+
+	since, err := PeriodStart(api.LeaderboardPeriod_PERIOD_ALL_TIME, now)
+	assert.NoError(t, err)
+	assert.Nil(t, since)
+
+	_, err = PeriodStart(42, now)
+	assert.ErrorIs(t, err, ErrPeriod)
+}
+
+func TestRecordTeamResults(t *testing.T) {
+	ctx, _ := logger.New(context.Background())
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	repo := &Repository{pg: mock}
+
+	teams := []*api.TeamResult{
+		{Team: "red", Score: 1500, Rank: 1, NumPlayers: 3},
+		{Team: "blue", Score: 900, Rank: 2, NumPlayers: 2},
+	}
+
+	t.Run("successful record", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("WITH inserted AS").
+			WithArgs("room", "abcde:1", "quiz1", "host", "red", float32(1500), int32(1), int32(2), int32(3), "sum").
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mock.ExpectExec("WITH inserted AS").
+			WithArgs("room", "abcde:1", "quiz1", "host", "blue", float32(900), int32(2), int32(2), int32(2), "sum").
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mock.ExpectCommit()
+
+		err := repo.RecordTeamResults(ctx, "room", "abcde:1", "quiz1", "host", api.TeamScoring_TEAM_SCORING_SUM, teams)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("repeated game changes nothing", func(t *testing.T) {
+		// Строки уже вставлены, ON CONFLICT DO NOTHING не затрагивает статистику
+		mock.ExpectBegin()
+		mock.ExpectExec("WITH inserted AS").
+			WithArgs("challenge", "7", "quiz1", "alice", "red", float32(1500), int32(1), int32(1), int32(3), "average").
+			WillReturnResult(pgxmock.NewResult("INSERT", 0))
+		mock.ExpectCommit()
+
+		err := repo.RecordTeamResults(ctx, "challenge", "7", "quiz1", "alice", api.TeamScoring_TEAM_SCORING_AVERAGE, teams[:1])
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("wrong format", func(t *testing.T) {
+		err := repo.RecordTeamResults(ctx, "room", "abcde:1", "quiz1", "host", api.TeamScoring_TEAM_SCORING_SUM,
+			[]*api.TeamResult{{Team: "", Rank: 1}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "wrong request format")
+	})
+
+	t.Run("database error rolls back the game", func(t *testing.T) {
+		// Первая команда записана, вторая нет: откат убирает и первую
+		mock.ExpectBegin()
+		mock.ExpectExec("WITH inserted AS").
+			WithArgs("room", "abcde:1", "quiz1", "host", "red", float32(1500), int32(1), int32(2), int32(3), "sum").
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mock.ExpectExec("WITH inserted AS").
+			WithArgs("room", "abcde:1", "quiz1", "host", "blue", float32(900), int32(2), int32(2), int32(2), "sum").
+			WillReturnError(errors.New("insert failed"))
+		mock.ExpectRollback()
+
+		err := repo.RecordTeamResults(ctx, "room", "abcde:1", "quiz1", "host", api.TeamScoring_TEAM_SCORING_SUM, teams)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unable to update team statistics")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestGetTeamStat(t *testing.T) {
+	ctx, _ := logger.New(context.Background())
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	repo := &Repository{pg: mock}
 
+	t.Run("successful get", func(t *testing.T) {
+		playedAt := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+		mock.ExpectQuery("SELECT").
+			WithArgs("host", "red").
+			WillReturnRows(pgxmock.NewRows([]string{"num_sessions", "wins", "avg_rank", "best_score", "avg_score"}).
+				AddRow(int32(2), int32(1), float32(1.5), float32(1500), float32(1200)))
 		mock.ExpectQuery("SELECT").
-			WillReturnRows(pgxmock.NewRows([]string{"user_id", "num_quizzes", "avg_quiz_rate", "best_quiz_rate"}).
-				AddRow(expected[0].UserId, expected[0].NumQuizzes, expected[0].AvgQuizRate, expected[0].BestQuizRate).
-				AddRow(expected[1].UserId, expected[1].NumQuizzes, expected[1].AvgQuizRate, expected[1].BestQuizRate))
+			WithArgs("host", "red", teamHistoryLimit).
+			WillReturnRows(pgxmock.NewRows([]string{"source", "source_id", "quiz_id", "score", "rank", "num_teams", "num_players", "scoring", "played_at"}).
+				AddRow("room", "abcde:1", "quiz1", float32(1500), int32(1), int32(2), int32(3), "sum", playedAt))
 
-		result, err := repo.ListAuthors(ctx, api.ListAuthorsOption_AVG_QUIZ_RATE)
+		stat, history, err := repo.GetTeamStat(ctx, "host", "red")
 		require.NoError(t, err)
-		assert.Equal(t, expected, result)
+		assert.Equal(t, &api.TeamStat{Owner: "host", Team: "red", NumSessions: 2, Wins: 1, AvgRank: 1.5, BestScore: 1500, AvgScore: 1200}, stat)
+		assert.Equal(t, []*api.TeamHistoryEntry{{
+			Source: "room", SourceId: "abcde:1", QuizId: "quiz1", Score: 1500, Rank: 1, NumTeams: 2, NumPlayers: 3,
+			Scoring: api.TeamScoring_TEAM_SCORING_SUM, PlayedAt: "2026-05-01T12:00:00Z",
+		}}, history)
 	})
 
-	t.Run("by BestQuizRate", func(t *testing.T) {
-		expected := []*api.AuthorStat{
-			{
-				UserId:       "author1",
-				NumQuizzes:   5,
-				AvgQuizRate:  float32(3.5),
-				BestQuizRate: float32(3.9),
-			},
-			{
-				UserId:       "author2",
-				NumQuizzes:   10,
-				AvgQuizRate:  float32(4.5),
-				BestQuizRate: float32(4.9),
-			},
-		}
+	t.Run("history iteration error", func(t *testing.T) {
+		mock.ExpectQuery("SELECT").
+			WithArgs("host", "red").
+			WillReturnRows(pgxmock.NewRows([]string{"num_sessions", "wins", "avg_rank", "best_score", "avg_score"}).
+				AddRow(int32(2), int32(1), float32(1.5), float32(1500), float32(1200)))
+		mock.ExpectQuery("SELECT").
+			WithArgs("host", "red", teamHistoryLimit).
+			WillReturnRows(pgxmock.NewRows([]string{"source", "source_id", "quiz_id", "score", "rank", "num_teams", "num_players", "scoring", "played_at"}).
+				RowError(0, errors.New("connection lost")))
 
+		_, _, err := repo.GetTeamStat(ctx, "host", "red")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unable to get team history")
+	})
+
+	t.Run("not found", func(t *testing.T) {
 		mock.ExpectQuery("SELECT").
-			WillReturnRows(pgxmock.NewRows([]string{"user_id", "num_quizzes", "avg_quiz_rate", "best_quiz_rate"}).
-				AddRow(expected[0].UserId, expected[0].NumQuizzes, expected[0].AvgQuizRate, expected[0].BestQuizRate).
-				AddRow(expected[1].UserId, expected[1].NumQuizzes, expected[1].AvgQuizRate, expected[1].BestQuizRate))
+			WithArgs("host", "green").
+			WillReturnError(pgx.ErrNoRows)
 
-		result, err := repo.ListAuthors(ctx, api.ListAuthorsOption_BEST_QUIZ_RATE)
+		stat, history, err := repo.GetTeamStat(ctx, "host", "green")
+		require.NoError(t, err)
+		assert.Equal(t, &api.TeamStat{Owner: "host", Team: "green"}, stat)
+		assert.Empty(t, history)
+	})
+}
+
+func TestListTeams(t *testing.T) {
+	ctx, _ := logger.New(context.Background())
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	repo := &Repository{pg: mock}
+
+	t.Run("by Wins", func(t *testing.T) {
+		expected := []*api.TeamStat{
+			{Owner: "host", Team: "red", NumSessions: 3, Wins: 2, AvgRank: 1.3, BestScore: 1500, AvgScore: 1200},
+			{Owner: "alice", Team: "owls", NumSessions: 1, Wins: 1, AvgRank: 1, BestScore: 800, AvgScore: 800},
+		}
+
+		rows := pgxmock.NewRows([]string{"owner", "team_name", "num_sessions", "wins", "avg_rank", "best_score", "avg_score"})
+		for _, team := range expected {
+			rows.AddRow(team.Owner, team.Team, team.NumSessions, team.Wins, team.AvgRank, team.BestScore, team.AvgScore)
+		}
+		mock.ExpectQuery("ORDER BY stats.teams.wins DESC").WithArgs(teamsLimit).WillReturnRows(rows)
+
+		result, err := repo.ListTeams(ctx, api.ListTeamsOption_TEAM_WINS)
 		require.NoError(t, err)
 		assert.Equal(t, expected, result)
 	})
 
+	t.Run("iteration error", func(t *testing.T) {
+		rows := pgxmock.NewRows([]string{"owner", "team_name", "num_sessions", "wins", "avg_rank", "best_score", "avg_score"}).
+			AddRow("host", "red", int32(3), int32(2), float32(1.3), float32(1500), float32(1200)).
+			RowError(1, errors.New("connection lost"))
+		mock.ExpectQuery("ORDER BY stats.teams.wins DESC").WithArgs(teamsLimit).WillReturnRows(rows)
+
+		_, err := repo.ListTeams(ctx, api.ListTeamsOption_TEAM_WINS)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unable to list teams")
+	})
+
 	t.Run("invalid option", func(t *testing.T) {
-		_, err := repo.ListAuthors(ctx, 999)
+		_, err := repo.ListTeams(ctx, 999)
 		assert.Error(t, err)
 	})
 }
@@ -440,3 +1008,94 @@ func TestCloseConn(t *testing.T) {
 	repo.CloseConn()
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestUpdateDailyStreak(t *testing.T) {
+	ctx, _ := logger.New(context.Background())
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	repo := &Repository{pg: mock}
+	day := time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC)
+
+	t.Run("successful update", func(t *testing.T) {
+		mock.ExpectExec("INSERT INTO stats.daily_streaks").
+			WithArgs("player1", day).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		err := repo.UpdateDailyStreak(ctx, "player1", day)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("wrong request", func(t *testing.T) {
+		err := repo.UpdateDailyStreak(ctx, "", day)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "wrong request format")
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mock.ExpectExec("INSERT INTO stats.daily_streaks").
+			WithArgs("player1", day).
+			WillReturnError(errors.New("update failed"))
+
+		err := repo.UpdateDailyStreak(ctx, "player1", day)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unable to update daily streak")
+	})
+}
+
+func TestGetDailyStreak(t *testing.T) {
+	ctx, _ := logger.New(context.Background())
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	repo := &Repository{pg: mock}
+	today := time.Date(2026, 5, 3, 15, 0, 0, 0, time.UTC)
+	columns := []string{"current_streak", "best_streak", "days_played", "last_date"}
+
+	t.Run("played yesterday", func(t *testing.T) {
+		mock.ExpectQuery("SELECT").
+			WithArgs("player1").
+			WillReturnRows(pgxmock.NewRows(columns).
+				AddRow(int32(4), int32(6), int32(10), time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC)))
+
+		streak, err := repo.GetDailyStreak(ctx, "player1", today)
+		require.NoError(t, err)
+		assert.Equal(t, &api.DailyStreak{UserId: "player1", CurrentStreak: 4, BestStreak: 6, DaysPlayed: 10, LastPlayed: "2026-05-02"}, streak)
+	})
+
+	t.Run("played today", func(t *testing.T) {
+		mock.ExpectQuery("SELECT").
+			WithArgs("player1").
+			WillReturnRows(pgxmock.NewRows(columns).
+				AddRow(int32(5), int32(6), int32(11), time.Date(2026, 5, 3, 0, 0, 0, 0, time.UTC)))
+
+		streak, err := repo.GetDailyStreak(ctx, "player1", today)
+		require.NoError(t, err)
+		assert.Equal(t, int32(5), streak.CurrentStreak)
+		assert.True(t, streak.PlayedToday)
+	})
+
+	t.Run("missed a day", func(t *testing.T) {
+		mock.ExpectQuery("SELECT").
+			WithArgs("player1").
+			WillReturnRows(pgxmock.NewRows(columns).
+				AddRow(int32(4), int32(6), int32(10), time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)))
+
+		streak, err := repo.GetDailyStreak(ctx, "player1", today)
+		require.NoError(t, err)
+		assert.Equal(t, &api.DailyStreak{UserId: "player1", CurrentStreak: 0, BestStreak: 6, DaysPlayed: 10, LastPlayed: "2026-05-01"}, streak)
+	})
+
+	t.Run("never played", func(t *testing.T) {
+		mock.ExpectQuery("SELECT").
+			WithArgs("player2").
+			WillReturnError(pgx.ErrNoRows)
+
+		streak, err := repo.GetDailyStreak(ctx, "player2", today)
+		require.NoError(t, err)
+		assert.Equal(t, &api.DailyStreak{UserId: "player2"}, streak)
+	})
+}
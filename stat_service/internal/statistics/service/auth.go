@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	authapi "quiz_app/pkg/authapi/v1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Auth проверяет токены пользователей в auth_service
+type Auth interface {
+	ValidateToken(context.Context, *authapi.ValidateTokenRequest, ...grpc.CallOption) (*authapi.ValidateTokenResponse, error)
+}
+
+// currentUser возвращает имя пользователя, которому принадлежит токен запроса, и исходящий
+// контекст с этим токеном для вызовов quiz_service от его имени.
+// Имя совпадает с id игрока в сессиях quiz_service.
+func (s *Service) currentUser(ctx context.Context) (context.Context, string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	authHeaders := md.Get("authorization")
+	if len(authHeaders) == 0 {
+		return nil, "", status.Error(codes.Unauthenticated, "authorization token is not provided")
+	}
+	token := strings.TrimPrefix(authHeaders[0], "Bearer ")
+	if token == "" {
+		return nil, "", status.Error(codes.Unauthenticated, "invalid auth token format")
+	}
+	if s.auth == nil {
+		return nil, "", status.Error(codes.Unavailable, "auth service is not available")
+	}
+	outgoing := metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+	resp, err := s.auth.ValidateToken(outgoing, &authapi.ValidateTokenRequest{Token: "Bearer " + token})
+	if err != nil {
+		return nil, "", status.Error(codes.Unauthenticated, "invalid token")
+	}
+	return outgoing, resp.GetUser().GetUsername(), nil
+}
+
+// optionalUser возвращает имя пользователя по токену запроса или пустую строку, если токена нет
+func (s *Service) optionalUser(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if len(md.Get("authorization")) == 0 {
+		return "", nil
+	}
+	_, user_id, err := s.currentUser(ctx)
+	return user_id, err
+}
@@ -2,42 +2,242 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"quiz_app/internal/statistics/repository"
 	api "quiz_app/pkg/api/v1"
 	"quiz_app/pkg/logger"
+	quizapi "quiz_app/pkg/quizapi/v1"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Repository interface {
-	UpdateStats(context.Context, string, string, string, float32, float32) error
+	UpdateStats(context.Context, string, *api.PlayedSession, []*quizapi.SessionAnswerResult) (*api.PlayedSession, bool, error)
+	ListPlayerSessions(context.Context, string, int32, string) ([]*api.PlayedSession, string, error)
+	ListQuizSessions(context.Context, string, int32, string) ([]*api.PlayedSession, string, error)
+
+	RateQuiz(context.Context, string, string, string, float32, string) (*api.QuizRating, *api.QuizStat, error)
+	ListQuizRatings(context.Context, string, int32, string) ([]*api.QuizRating, string, error)
 
 	GetQuizStat(context.Context, string) (*api.QuizStat, error)
-	ListQuizzes(context.Context, api.ListQuizzesOption) ([]*api.QuizStat, error)
+	ListQuizzes(context.Context, api.ListQuizzesOption, repository.Leaderboard) ([]*api.QuizStat, []*api.QuizStat, string, error)
+
+	GetQuestionStats(context.Context, string) (int32, []*api.QuestionStat, error)
 
 	GetPlayerStat(context.Context, string) (*api.PlayerStat, error)
-	ListPlayers(context.Context, api.ListPlayersOption) ([]*api.PlayerStat, error)
+	ListPlayers(context.Context, api.ListPlayersOption, repository.Leaderboard) ([]*api.PlayerStat, *api.PlayerStat, string, error)
 
 	GetAuthorStat(context.Context, string) (*api.AuthorStat, error)
-	ListAuthors(context.Context, api.ListAuthorsOption) ([]*api.AuthorStat, error)
+	ListAuthors(context.Context, api.ListAuthorsOption, repository.Leaderboard) ([]*api.AuthorStat, *api.AuthorStat, string, error)
+
+	RecordTeamResults(context.Context, string, string, string, string, api.TeamScoring, []*api.TeamResult) error
+	GetTeamStat(context.Context, string, string) (*api.TeamStat, []*api.TeamHistoryEntry, error)
+	ListTeams(context.Context, api.ListTeamsOption) ([]*api.TeamStat, error)
+
+	UpdateDailyStreak(context.Context, string, time.Time) error
+	GetDailyStreak(context.Context, string, time.Time) (*api.DailyStreak, error)
+}
+
+// Sessions читает игровые сессии и квизы из quiz_service
+type Sessions interface {
+	GetSessionResult(context.Context, *quizapi.GetSessionResultRequest, ...grpc.CallOption) (*quizapi.GetSessionResultResponse, error)
+	GetQuiz(context.Context, *quizapi.GetQuizRequest, ...grpc.CallOption) (*quizapi.GetQuizResponse, error)
 }
 
 type Service struct {
 	api.StatisticsServer
-	repo Repository
+	repo     Repository
+	sessions Sessions
+	auth     Auth
 }
 
-func New(ctx context.Context, repo Repository) *Service {
-	return &Service{repo: repo}
+func New(ctx context.Context, repo Repository, sessions Sessions, auth Auth) *Service {
+	return &Service{repo: repo, sessions: sessions, auth: auth}
 }
 
 func (s *Service) UpdateStats(ctx context.Context, r *api.UpdateStatsRequest) (*api.UpdateStatsResponse, error) {
-	quiz_id, author_id, player_id, player_score, quiz_rate := r.GetQuizId(), r.GetAuthorId(), r.GetPlayerId(), r.GetPlayerScore(), r.GetQuizRate()
-	err := s.repo.UpdateStats(ctx, quiz_id, author_id, player_id, player_score, quiz_rate)
+	if r.GetSessionId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	// Засчитать сессию может только ее игрок
+	outgoing, user_id, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	session, err := s.sessions.GetSessionResult(outgoing, &quizapi.GetSessionResultRequest{SessionId: r.GetSessionId()})
 	if err != nil {
 		logger.GetLoggerFromCtx(ctx).Error(ctx, err.Error())
 		return nil, err
 	}
+	if !session.GetFinished() {
+		return nil, status.Error(codes.FailedPrecondition, "session is not finished")
+	}
+	if session.GetPlayer() == "" {
+		return nil, status.Error(codes.FailedPrecondition, "anonymous sessions are not counted")
+	}
+	if session.GetPlayer() != user_id || (r.GetPlayerId() != "" && r.GetPlayerId() != user_id) {
+		return nil, status.Error(codes.PermissionDenied, "session belongs to another player")
+	}
+	// Балл, квиз, автор, игрок и время берутся из сессии, оценка ставится отдельно через RateQuiz
+	quiz_id, player_id := session.GetQuizId(), session.GetPlayer()
+	played := &api.PlayedSession{
+		SessionId: session.GetSessionId(),
+		QuizId:    quiz_id,
+		AuthorId:  session.GetAuthor(),
+		PlayerId:  player_id,
+		Score:     session.GetScore(),
+		PlayedAt:  time.Now().UTC().Format(time.RFC3339Nano),
+	}
+	if finished_at, err := time.Parse(time.RFC3339Nano, session.GetFinishedAt()); err == nil {
+		played.PlayedAt = finished_at.UTC().Format(time.RFC3339Nano)
+		if started_at, err := time.Parse(time.RFC3339Nano, session.GetStartedAt()); err == nil {
+			played.DurationMs = finished_at.Sub(started_at).Milliseconds()
+		}
+	}
+	key := r.GetIdempotencyKey()
+	if key == "" {
+		key = r.GetSessionId()
+	}
+	recorded, replayed, err := s.repo.UpdateStats(ctx, key, played, session.GetAnswers())
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Error(ctx, err.Error())
+		return nil, err
+	}
+	// Сессия квиза дня продлевает серию игрока. Отметка дня идемпотентна,
+	// поэтому повтор запроса доводит ее до конца, если в первый раз она не удалась
+	if session.GetDailyDate() != "" {
+		day, err := time.Parse(time.DateOnly, session.GetDailyDate())
+		if err == nil {
+			err = s.repo.UpdateDailyStreak(ctx, player_id, day)
+		}
+		if err != nil {
+			logger.GetLoggerFromCtx(ctx).Error(ctx, err.Error())
+			return nil, err
+		}
+	}
+	if replayed {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("session %s is already counted", recorded.GetSessionId()))
+		return &api.UpdateStatsResponse{Session: recorded, Replayed: true}, nil
+	}
 	logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("statistics updated by quiz %s", quiz_id))
-	return &api.UpdateStatsResponse{}, nil
+	return &api.UpdateStatsResponse{Session: recorded}, nil
+}
+
+// Размер страницы списков
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+func pageSize(size int32) (int32, error) {
+	if size < 0 || size > maxPageSize {
+		return 0, status.Errorf(codes.InvalidArgument, "page_size must be between 0 and %d", maxPageSize)
+	}
+	if size == 0 {
+		return defaultPageSize, nil
+	}
+	return size, nil
+}
+
+func (s *Service) ListPlayerSessions(ctx context.Context, r *api.ListPlayerSessionsRequest) (*api.ListSessionsResponse, error) {
+	user_id := r.GetUserId()
+	if user_id == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	page_size, err := pageSize(r.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+	sessions, next_token, err := s.repo.ListPlayerSessions(ctx, user_id, page_size, r.GetPageToken())
+	if err != nil {
+		return nil, pageError(ctx, err)
+	}
+	logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("sessions of player %s listed", user_id))
+	return &api.ListSessionsResponse{Sessions: sessions, NextPageToken: next_token}, nil
+}
+
+func (s *Service) ListQuizSessions(ctx context.Context, r *api.ListQuizSessionsRequest) (*api.ListSessionsResponse, error) {
+	quiz_id := r.GetQuizId()
+	if quiz_id == "" {
+		return nil, status.Error(codes.InvalidArgument, "quiz_id is required")
+	}
+	page_size, err := pageSize(r.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+	sessions, next_token, err := s.repo.ListQuizSessions(ctx, quiz_id, page_size, r.GetPageToken())
+	if err != nil {
+		return nil, pageError(ctx, err)
+	}
+	logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("sessions of quiz %s listed", quiz_id))
+	return &api.ListSessionsResponse{Sessions: sessions, NextPageToken: next_token}, nil
+}
+
+func pageError(ctx context.Context, err error) error {
+	if errors.Is(err, repository.ErrPageToken) {
+		return status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+	logger.GetLoggerFromCtx(ctx).Error(ctx, err.Error())
+	return err
+}
+
+// Максимальная длина отзыва в символах
+const maxReviewLength = 2000
+
+func (s *Service) RateQuiz(ctx context.Context, r *api.RateQuizRequest) (*api.RateQuizResponse, error) {
+	outgoing, user_id, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	quiz_id := r.GetQuizId()
+	if quiz_id == "" {
+		return nil, status.Error(codes.InvalidArgument, "quiz_id is required")
+	}
+	if r.GetRate() < 1 || r.GetRate() > 5 {
+		return nil, status.Error(codes.InvalidArgument, "rate must be between 1 and 5")
+	}
+	if utf8.RuneCountInString(r.GetReview()) > maxReviewLength {
+		return nil, status.Errorf(codes.InvalidArgument, "review must be at most %d characters", maxReviewLength)
+	}
+	// Оценить можно только опубликованный квиз, даже если его еще никто не доиграл.
+	// Квиз запрашивается от имени пользователя, чтобы были видны квизы для друзей
+	quiz, err := s.sessions.GetQuiz(outgoing, &quizapi.GetQuizRequest{QuizId: quiz_id})
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Error(ctx, err.Error())
+		return nil, err
+	}
+	rating, quiz_stat, err := s.repo.RateQuiz(ctx, quiz_id, quiz.GetAuthor(), user_id, r.GetRate(), r.GetReview())
+	if err != nil {
+		if errors.Is(err, repository.ErrOwnQuiz) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		logger.GetLoggerFromCtx(ctx).Error(ctx, err.Error())
+		return nil, err
+	}
+	logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("quiz %s rated by %s", quiz_id, user_id))
+	return &api.RateQuizResponse{Rating: rating, Quiz: quiz_stat}, nil
+}
+
+func (s *Service) ListQuizRatings(ctx context.Context, r *api.ListQuizRatingsRequest) (*api.ListQuizRatingsResponse, error) {
+	quiz_id := r.GetQuizId()
+	if quiz_id == "" {
+		return nil, status.Error(codes.InvalidArgument, "quiz_id is required")
+	}
+	page_size, err := pageSize(r.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+	ratings, next_token, err := s.repo.ListQuizRatings(ctx, quiz_id, page_size, r.GetPageToken())
+	if err != nil {
+		return nil, pageError(ctx, err)
+	}
+	logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("ratings of quiz %s listed", quiz_id))
+	return &api.ListQuizRatingsResponse{Ratings: ratings, NextPageToken: next_token}, nil
 }
 
 func (s *Service) GetQuizStat(ctx context.Context, r *api.GetQuizStatRequest) (*api.GetQuizStatResponse, error) {
@@ -51,15 +251,75 @@ func (s *Service) GetQuizStat(ctx context.Context, r *api.GetQuizStatRequest) (*
 	return &api.GetQuizStatResponse{Quiz: quiz_stat}, nil
 }
 
+// leaderboard проверяет параметры таблицы. Если в запросе есть токен, вместе со страницей
+// возвращается место вызывающего.
+func (s *Service) leaderboard(ctx context.Context, period api.LeaderboardPeriod, direction api.SortDirection, size int32, token string) (repository.Leaderboard, error) {
+	page_size, err := pageSize(size)
+	if err != nil {
+		return repository.Leaderboard{}, err
+	}
+	since, err := repository.PeriodStart(period, time.Now().UTC())
+	if err != nil {
+		return repository.Leaderboard{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if _, ok := api.SortDirection_name[int32(direction)]; !ok {
+		return repository.Leaderboard{}, status.Error(codes.InvalidArgument, "unknown sort direction")
+	}
+	user_id, err := s.optionalUser(ctx)
+	if err != nil {
+		return repository.Leaderboard{}, err
+	}
+	return repository.Leaderboard{
+		Since:     since,
+		Ascending: direction == api.SortDirection_DIRECTION_ASC,
+		PageSize:  page_size,
+		PageToken: token,
+		UserID:    user_id,
+	}, nil
+}
+
 func (s *Service) ListQuizzes(ctx context.Context, r *api.ListQuizzesRequest) (*api.ListQuizzesResponse, error) {
-	option := r.Option
-	result, err := s.repo.ListQuizzes(ctx, option)
+	board, err := s.leaderboard(ctx, r.GetPeriod(), r.GetDirection(), r.GetPageSize(), r.GetPageToken())
 	if err != nil {
-		logger.GetLoggerFromCtx(ctx).Error(ctx, err.Error())
 		return nil, err
 	}
+	result, mine, next_token, err := s.repo.ListQuizzes(ctx, r.GetOption(), board)
+	if err != nil {
+		return nil, pageError(ctx, err)
+	}
 	logger.GetLoggerFromCtx(ctx).Info(ctx, "quizzes listed")
-	return &api.ListQuizzesResponse{Quizzes: result}, nil
+	return &api.ListQuizzesResponse{Quizzes: result, NextPageToken: next_token, Mine: mine}, nil
+}
+
+// GetQuestionStats отдает статистику вопросов квиза только его автору: по неверным
+// вариантам, которые выбирали игроки, можно вычислить верные ответы
+func (s *Service) GetQuestionStats(ctx context.Context, r *api.GetQuestionStatsRequest) (*api.GetQuestionStatsResponse, error) {
+	_, user_id, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	quiz_id := r.GetQuizId()
+	if quiz_id == "" {
+		return nil, status.Error(codes.InvalidArgument, "quiz_id is required")
+	}
+	quiz_stat, err := s.repo.GetQuizStat(ctx, quiz_id)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Error(ctx, err.Error())
+		return nil, err
+	}
+	if quiz_stat.GetAuthorId() == "" {
+		return nil, status.Error(codes.NotFound, repository.ErrQuizNotFound.Error())
+	}
+	if quiz_stat.GetAuthorId() != user_id {
+		return nil, status.Error(codes.PermissionDenied, "only the author can see question statistics")
+	}
+	num_sessions, questions, err := s.repo.GetQuestionStats(ctx, quiz_id)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Error(ctx, err.Error())
+		return nil, err
+	}
+	logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("question statistics of quiz %s found", quiz_id))
+	return &api.GetQuestionStatsResponse{QuizId: quiz_id, NumSessions: num_sessions, Questions: questions}, nil
 }
 
 func (s *Service) GetPlayerStat(ctx context.Context, r *api.GetPlayerStatRequest) (*api.GetPlayerStatResponse, error) {
@@ -74,14 +334,16 @@ func (s *Service) GetPlayerStat(ctx context.Context, r *api.GetPlayerStatRequest
 }
 
 func (s *Service) ListPlayers(ctx context.Context, r *api.ListPlayersRequest) (*api.ListPlayersResponse, error) {
-	option := r.Option
-	result, err := s.repo.ListPlayers(ctx, option)
+	board, err := s.leaderboard(ctx, r.GetPeriod(), r.GetDirection(), r.GetPageSize(), r.GetPageToken())
 	if err != nil {
-		logger.GetLoggerFromCtx(ctx).Error(ctx, err.Error())
 		return nil, err
 	}
+	result, me, next_token, err := s.repo.ListPlayers(ctx, r.GetOption(), board)
+	if err != nil {
+		return nil, pageError(ctx, err)
+	}
 	logger.GetLoggerFromCtx(ctx).Info(ctx, "players listed")
-	return &api.ListPlayersResponse{Players: result}, nil
+	return &api.ListPlayersResponse{Players: result, NextPageToken: next_token, Me: me}, nil
 }
 
 func (s *Service) GetAuthorStat(ctx context.Context, r *api.GetAuthorStatRequest) (*api.GetAuthorStatResponse, error) {
@@ -96,12 +358,68 @@ func (s *Service) GetAuthorStat(ctx context.Context, r *api.GetAuthorStatRequest
 }
 
 func (s *Service) ListAuthors(ctx context.Context, r *api.ListAuthorsRequest) (*api.ListAuthorsResponse, error) {
+	board, err := s.leaderboard(ctx, r.GetPeriod(), r.GetDirection(), r.GetPageSize(), r.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	result, me, next_token, err := s.repo.ListAuthors(ctx, r.GetOption(), board)
+	if err != nil {
+		return nil, pageError(ctx, err)
+	}
+	logger.GetLoggerFromCtx(ctx).Info(ctx, "authors listed")
+	return &api.ListAuthorsResponse{Authors: result, NextPageToken: next_token, Me: me}, nil
+}
+
+// Источники командных результатов
+var teamSources = map[string]bool{"room": true, "challenge": true}
+
+func (s *Service) RecordTeamResults(ctx context.Context, r *api.RecordTeamResultsRequest) (*api.RecordTeamResultsResponse, error) {
+	if !teamSources[r.GetSource()] {
+		return nil, status.Error(codes.InvalidArgument, "source must be room or challenge")
+	}
+	if r.GetSourceId() == "" || r.GetOwner() == "" || len(r.GetTeams()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "source_id, owner and teams are required")
+	}
+	err := s.repo.RecordTeamResults(ctx, r.GetSource(), r.GetSourceId(), r.GetQuizId(), r.GetOwner(), r.GetScoring(), r.GetTeams())
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Error(ctx, err.Error())
+		return nil, err
+	}
+	logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("team results recorded by %s %s", r.GetSource(), r.GetSourceId()))
+	return &api.RecordTeamResultsResponse{}, nil
+}
+
+func (s *Service) GetTeamStat(ctx context.Context, r *api.GetTeamStatRequest) (*api.GetTeamStatResponse, error) {
+	team_stat, history, err := s.repo.GetTeamStat(ctx, r.Owner, r.Team)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Error(ctx, err.Error())
+		return nil, err
+	}
+	logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("team %s of %s found", r.Team, r.Owner))
+	return &api.GetTeamStatResponse{Team: team_stat, History: history}, nil
+}
+
+func (s *Service) ListTeams(ctx context.Context, r *api.ListTeamsRequest) (*api.ListTeamsResponse, error) {
 	option := r.Option
-	result, err := s.repo.ListAuthors(ctx, option)
+	result, err := s.repo.ListTeams(ctx, option)
 	if err != nil {
 		logger.GetLoggerFromCtx(ctx).Error(ctx, err.Error())
 		return nil, err
 	}
-	logger.GetLoggerFromCtx(ctx).Info(ctx, "authors listed")
-	return &api.ListAuthorsResponse{Authors: result}, nil
+	logger.GetLoggerFromCtx(ctx).Info(ctx, "teams listed")
+	return &api.ListTeamsResponse{Teams: result}, nil
+}
+
+func (s *Service) GetDailyStreak(ctx context.Context, r *api.GetDailyStreakRequest) (*api.GetDailyStreakResponse, error) {
+	user_id := r.GetUserId()
+	if user_id == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	streak, err := s.repo.GetDailyStreak(ctx, user_id, time.Now().UTC())
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Error(ctx, err.Error())
+		return nil, err
+	}
+	logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("daily streak of %s found", user_id))
+	return &api.GetDailyStreakResponse{Streak: streak}, nil
 }
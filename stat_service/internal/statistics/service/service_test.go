@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	api "quiz_app/pkg/api/v1"
+	authapi "quiz_app/pkg/authapi/v1"
+	"quiz_app/pkg/logger"
+	quizapi "quiz_app/pkg/quizapi/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// authStub принимает любой токен как токен пользователя user
+type authStub struct {
+	user string
+}
+
+func (a authStub) ValidateToken(context.Context, *authapi.ValidateTokenRequest, ...grpc.CallOption) (*authapi.ValidateTokenResponse, error) {
+	return &authapi.ValidateTokenResponse{Valid: true, User: &authapi.UserResponse{Username: a.user}}, nil
+}
+
+// friendsQuiz отдает квиз для друзей автора только запросу с токеном друга
+type friendsQuiz struct {
+	Sessions
+	token string
+}
+
+func (f friendsQuiz) GetQuiz(ctx context.Context, r *quizapi.GetQuizRequest, _ ...grpc.CallOption) (*quizapi.GetQuizResponse, error) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if auth := md.Get("authorization"); len(auth) == 0 || auth[0] != "Bearer "+f.token {
+		return nil, status.Error(codes.NotFound, "quiz not found")
+	}
+	return &quizapi.GetQuizResponse{ShortID: r.GetQuizId(), Author: "author1"}, nil
+}
+
+// ratingRepo запоминает сохраненную оценку
+type ratingRepo struct {
+	Repository
+	author string
+}
+
+func (r *ratingRepo) RateQuiz(_ context.Context, quiz_id string, author_id string, user_id string, rate float32, review string) (*api.QuizRating, *api.QuizStat, error) {
+	r.author = author_id
+	return &api.QuizRating{QuizId: quiz_id, UserId: user_id, Rate: rate, Review: review},
+		&api.QuizStat{QuizId: quiz_id, AuthorId: author_id, AvgRate: rate, NumRatings: 1}, nil
+}
+
+func TestRateQuizFriendsOnly(t *testing.T) {
+	ctx, err := logger.New(context.Background())
+	require.NoError(t, err)
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer friend-token"))
+
+	// Квиз для друзей виден quiz_service только с токеном друга, поэтому токен передается дальше
+	repo := &ratingRepo{}
+	s := New(ctx, repo, friendsQuiz{token: "friend-token"}, authStub{user: "friend1"})
+	resp, err := s.RateQuiz(ctx, &api.RateQuizRequest{QuizId: "quiz1", Rate: 5})
+	require.NoError(t, err)
+	assert.Equal(t, "friend1", resp.GetRating().GetUserId())
+	assert.Equal(t, "author1", repo.author)
+}